@@ -123,6 +123,11 @@ func (dingocli *DingoCli) init() error {
 		return err
 	}
 	configure.ReplaceGlobals(config)
+	module.ReplaceGlobalSSHPool(module.NewSSHPool(module.SSHPoolOption{
+		Enable:      config.GetSSHPool(),
+		MaxSessions: config.GetSSHSessions(),
+		IdleTimeout: time.Duration(config.GetSSHIdle()) * time.Second,
+	}))
 
 	// (3) Init logger
 	now := time.Now().Format("2006-01-02_15-04-05")
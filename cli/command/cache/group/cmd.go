@@ -31,6 +31,8 @@ func NewCacheGroupCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	cmd.AddCommand(
 		NewCacheGroupListCommand(dingocli),
+		NewCacheGroupCreateCommand(dingocli),
+		NewCacheGroupDeleteCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package group
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHEGROUP_CREATE_EXAMPLE = `Examples:
+   $ dingo cache group create group1`
+)
+
+type createOptions struct {
+	group  string
+	format string
+}
+
+func NewCacheGroupCreateCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options createOptions
+
+	cmd := &cobra.Command{
+		Use:     "create GROUP [OPTIONS]",
+		Short:   "create cache group",
+		Args:    utils.ExactArgs(1),
+		Example: CACHEGROUP_CREATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.group = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runCreate(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runCreate(cmd *cobra.Command, dingocli *cli.DingoCli, options createOptions) error {
+	// new rpc
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "CreateCacheGroup")
+	if err != nil {
+		return err
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	// set request info
+	createRpc := &rpc.CreateCacheGroupRpc{
+		Info: mdsRpc,
+		Request: &mds.CreateGroupRequest{
+			GroupName: options.group,
+		},
+	}
+
+	// get rpc result
+	response, rpcError := rpc.GetRpcResponse(createRpc.Info, createRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		outputResult.Error = rpcError
+	} else {
+		result := response.(*mds.CreateGroupResponse)
+		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
+		}
+		outputResult.Result = result
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	fmt.Printf("Successfully create cachegroup %s\n", options.group)
+
+	return nil
+}
@@ -35,6 +35,8 @@ func NewCacheMemberCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewCacheMemberDeleteCommand(dingocli),
 		NewCacheMemberUnlockCommand(dingocli),
 		NewCacheMemberLeaveCommand(dingocli),
+		NewCacheMemberStatsCommand(dingocli),
+		NewCacheMemberJoinCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package member
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHEMEMBER_JOIN_EXAMPLE = `Examples:
+   $ dingo cache member join --group group1 --memberid 6ba7b810-9dad-11d1-80b4-00c04fd430c8 --ip 10.220.69.6 --port 10001 --weight 100`
+
+	CACHE_MEMBER_DIAL_TIMEOUT = 3 * time.Second
+)
+
+type joinOptions struct {
+	group    string
+	memberid string
+	ip       string
+	port     uint32
+	weight   uint32
+	format   string
+}
+
+func NewCacheMemberJoinCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options joinOptions
+
+	cmd := &cobra.Command{
+		Use:     "join --group GROUP --memberid ID --ip IP --port PORT [OPTIONS]",
+		Short:   "join cache member into cache group",
+		Args:    utils.NoArgs,
+		Example: CACHEMEMBER_JOIN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			utils.ReadCommandConfig(cmd)
+
+			options.group = utils.GetStringFlag(cmd, utils.DINGOFS_CACHE_GROUP)
+			options.memberid = utils.GetStringFlag(cmd, utils.DINGOFS_CACHE_MEMBERID)
+			options.ip = utils.GetStringFlag(cmd, utils.DINGOFS_CACHE_IP)
+			options.port = utils.GetUint32Flag(cmd, utils.DINGOFS_CACHE_PORT)
+			options.weight, err = cmd.Flags().GetUint32("weight")
+			if err != nil {
+				return err
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runJoin(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddStringRequiredFlag(cmd, utils.DINGOFS_CACHE_GROUP, "Cache group name")
+	utils.AddStringRequiredFlag(cmd, utils.DINGOFS_CACHE_MEMBERID, "Cache member id of the running cache node")
+	utils.AddStringRequiredFlag(cmd, utils.DINGOFS_CACHE_IP, "Cache member ip")
+	utils.AddUint32RequiredFlag(cmd, utils.DINGOFS_CACHE_PORT, "Cache member port")
+	cmd.Flags().Uint32("weight", 100, "Cache member weight")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// checkMemberReachable makes sure the cache member is listening on the endpoint,
+// otherwise the member will be unstable once joined
+func checkMemberReachable(ip string, port uint32) error {
+	endpoint := net.JoinHostPort(ip, strconv.FormatUint(uint64(port), 10))
+	conn, err := net.DialTimeout("tcp", endpoint, CACHE_MEMBER_DIAL_TIMEOUT)
+	if err != nil {
+		return errno.ERR_CACHE_MEMBER_UNREACHABLE.E(err)
+	}
+	conn.Close()
+	return nil
+}
+
+func runJoin(cmd *cobra.Command, dingocli *cli.DingoCli, options joinOptions) error {
+	if err := checkMemberReachable(options.ip, options.port); err != nil {
+		return err
+	}
+
+	// new rpc
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "JoinCacheMember")
+	if err != nil {
+		return err
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	// set request info
+	joinRpc := &rpc.JoinCacheMemberRpc{
+		Info: mdsRpc,
+		Request: &mds.JoinCacheGroupRequest{
+			GroupName: options.group,
+			MemberId:  options.memberid,
+			Ip:        options.ip,
+			Port:      options.port,
+			Weight:    options.weight,
+		},
+	}
+
+	// get rpc result
+	response, rpcError := rpc.GetRpcResponse(joinRpc.Info, joinRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		outputResult.Error = rpcError
+	} else {
+		result := response.(*mds.JoinCacheGroupResponse)
+		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
+		}
+		outputResult.Result = result
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	fmt.Printf("Successfully join cachemember %s into cachegroup %s\n", options.memberid, options.group)
+
+	return nil
+}
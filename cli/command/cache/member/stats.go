@@ -0,0 +1,377 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package member
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHEMEMBER_STATS_EXAMPLE = `Examples:
+   $ dingo cache member stats
+   $ dingo cache member stats --group group1 --interval 5s
+   $ dingo cache member stats --count 1 --format json`
+
+	URL_CACHE_VARS      = "http://%s:%d/vars"
+	CACHE_STATS_TIMEOUT = 3 * time.Second
+	ANSI_CLEAR_SCREEN   = "\033[H\033[2J"
+
+	BALANCE_OK        = "ok"
+	BALANCE_OVERLOAD  = "overload"
+	BALANCE_UNDERLOAD = "underload"
+)
+
+// rpc methods of block cache service, the name is the underscored method name used by brpc
+var cacheMethods = []string{"range", "put", "cache", "prefetch"}
+
+type statsOptions struct {
+	group     string
+	interval  time.Duration
+	count     uint32
+	threshold float64
+	format    string
+}
+
+type memberStats struct {
+	MemberId   string  `json:"memberid"`
+	Group      string  `json:"group"`
+	Endpoint   string  `json:"endpoint"`
+	Weight     uint32  `json:"weight"`
+	State      string  `json:"state"`
+	HitRatio   float64 `json:"hit_ratio"`
+	LoadBytes  float64 `json:"load_bytes_per_second"`
+	StageBytes float64 `json:"stage_bytes_per_second"`
+	CacheBytes float64 `json:"cache_bytes_per_second"`
+	UsedBytes  uint64  `json:"used_bytes"`
+	FreeBytes  uint64  `json:"free_bytes"`
+	Ops        float64 `json:"ops"`
+	LatencyMs  float64 `json:"latency_ms"`
+	Balance    string  `json:"balance"`
+	Error      string  `json:"error,omitempty"`
+
+	ip      string
+	port    uint32
+	sampled bool // both samples of the interval are read
+}
+
+type memberSample struct {
+	vars map[string]float64
+	at   time.Time // when the vars are read
+	err  error
+}
+
+func NewCacheMemberStatsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options statsOptions
+
+	cmd := &cobra.Command{
+		Use:     "stats [OPTIONS]",
+		Short:   "show real time statistics of cache members",
+		Args:    utils.NoArgs,
+		Example: CACHEMEMBER_STATS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.group = utils.GetStringFlag(cmd, utils.DINGOFS_CACHE_GROUP)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runStats(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.DurationVarP(&options.interval, "interval", "i", 1*time.Second, "Interval time for every output")
+	flags.Uint32VarP(&options.count, "count", "c", 0, "Max output count(0 is unlimited)")
+	flags.Float64Var(&options.threshold, "threshold", 0.5, "Flag the member whose load share deviates from its weight share more than the ratio")
+	utils.AddStringFlag(cmd, utils.DINGOFS_CACHE_GROUP, "Cachegroup name")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// reset clears the statistics of last interval
+func (m *memberStats) reset() {
+	m.HitRatio, m.LoadBytes, m.StageBytes, m.CacheBytes = 0, 0, 0, 0
+	m.UsedBytes, m.FreeBytes = 0, 0
+	m.Ops, m.LatencyMs = 0, 0
+	m.Balance, m.Error = "", ""
+	m.sampled = false
+}
+
+func fetchCacheVars(client *http.Client, ip string, port uint32) (map[string]float64, error) {
+	url := fmt.Sprintf(URL_CACHE_VARS, ip, port)
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, errno.ERR_READ_CACHE_METRIC_FAILED.E(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errno.ERR_READ_CACHE_METRIC_FAILED.F("request %s failed, response (code: %d, msg: %s)",
+			url, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errno.ERR_READ_CACHE_METRIC_FAILED.E(err)
+	}
+	return utils.ParseStats(string(data)), nil
+}
+
+// sampleMembers reads the vars of all members concurrently
+func sampleMembers(client *http.Client, members []*memberStats) []memberSample {
+	var wg sync.WaitGroup
+	samples := make([]memberSample, len(members))
+	for i := range members {
+		wg.Add(1)
+		go func(i int, member *memberStats) {
+			defer wg.Done()
+			start := time.Now()
+			vars, err := fetchCacheVars(client, member.ip, member.port)
+			// the vars are generated somewhere in the request, take the middle of it
+			at := start.Add(time.Since(start) / 2)
+			samples[i] = memberSample{vars: vars, at: at, err: err}
+		}(i, members[i])
+	}
+	wg.Wait()
+	return samples
+}
+
+// rpcStats returns the requests count of block cache service and
+// the latency (us) weighted by qps of every method
+func rpcStats(vars map[string]float64) (count, latency float64) {
+	var qps, weighted float64
+	for key, value := range vars {
+		if !strings.HasPrefix(key, "rpc_server_") {
+			continue
+		}
+		for _, method := range cacheMethods {
+			prefix := "_" + method + "_"
+			if strings.HasSuffix(key, prefix+"count") {
+				count += value
+			} else if strings.HasSuffix(key, prefix+"latency") {
+				q := vars[strings.TrimSuffix(key, "latency")+"qps"]
+				qps += q
+				weighted += value * q
+			}
+		}
+	}
+	if qps > 0 {
+		latency = weighted / qps
+	}
+	return count, latency
+}
+
+// calcMemberStats calculates the statistics between two samples, elapsed is the time between them
+func calcMemberStats(stats *memberStats, left, right map[string]float64, elapsed time.Duration) {
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		return
+	}
+	diff := func(name string) float64 {
+		return right[name] - left[name]
+	}
+
+	hits := diff("dingofs_disk_cache_group_hit_count")
+	total := hits + diff("dingofs_disk_cache_group_miss_count")
+	if total > 0 {
+		stats.HitRatio = hits / total
+	}
+	stats.LoadBytes = diff("dingofs_disk_cache_group_load_total_bytes") / seconds
+	stats.StageBytes = diff("dingofs_disk_cache_group_stage_total_bytes") / seconds
+	stats.CacheBytes = diff("dingofs_disk_cache_group_cache_total_bytes") / seconds
+
+	used := right["dingofs_disk_cache_group_used_bytes"]
+	capacity := right["dingofs_disk_cache_group_capacity_bytes"]
+	stats.UsedBytes = uint64(used)
+	if capacity > used {
+		stats.FreeBytes = uint64(capacity - used)
+	}
+
+	leftCount, _ := rpcStats(left)
+	rightCount, latency := rpcStats(right)
+	stats.Ops = (rightCount - leftCount) / seconds
+	stats.LatencyMs = latency / 1000 // us -> ms
+	stats.sampled = true
+}
+
+// markBalance compares the load share with the weight share of every member in the same group,
+// the member is flagged if the deviation exceeds the threshold. The member which has no statistics
+// of the interval or zero weight is excluded from the group.
+func markBalance(members []*memberStats, threshold float64) {
+	balanced := func(member *memberStats) bool {
+		return member.sampled && len(member.Error) == 0 && member.Weight > 0
+	}
+
+	totalLoad := map[string]float64{}
+	totalWeight := map[string]float64{}
+	for _, member := range members {
+		if balanced(member) {
+			totalLoad[member.Group] += member.LoadBytes
+			totalWeight[member.Group] += float64(member.Weight)
+		}
+	}
+
+	for _, member := range members {
+		load, weight := totalLoad[member.Group], totalWeight[member.Group]
+		if !balanced(member) || load <= 0 || weight <= 0 {
+			member.Balance = common.ROW_VALUE_NO_VALUE
+			continue
+		}
+		expect := float64(member.Weight) / weight
+		deviation := member.LoadBytes/load/expect - 1
+		switch {
+		case deviation > threshold:
+			member.Balance = fmt.Sprintf("%s(+%.0f%%)", BALANCE_OVERLOAD, deviation*100)
+		case deviation < -threshold:
+			member.Balance = fmt.Sprintf("%s(%.0f%%)", BALANCE_UNDERLOAD, deviation*100)
+		default:
+			member.Balance = BALANCE_OK
+		}
+	}
+}
+
+func renderStats(members []*memberStats) string {
+	var buffer bytes.Buffer
+	table := tablewriter.NewWriter(&buffer)
+	table.SetAutoFormatHeaders(false)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeader([]string{common.ROW_MEMBERID, common.ROW_GROUP, "endpoint", common.ROW_WEIGHT, common.ROW_STATE,
+		"hit", "load/s", "stage/s", "cache/s", common.ROW_USED, "free", "ops/s", "latency", "balance"})
+	for _, member := range members {
+		row := []string{member.MemberId, member.Group, member.Endpoint, fmt.Sprintf("%d", member.Weight), member.State}
+		if len(member.Error) != 0 {
+			row = append(row, color.RedString("unreachable"), "", "", "", "", "", "", "", member.Balance)
+			table.Append(row)
+			continue
+		}
+		balance := member.Balance
+		if strings.HasPrefix(balance, BALANCE_OVERLOAD) || strings.HasPrefix(balance, BALANCE_UNDERLOAD) {
+			balance = color.YellowString(balance)
+		}
+		row = append(row,
+			fmt.Sprintf("%.1f%%", member.HitRatio*100),
+			humanize.IBytes(uint64(member.LoadBytes)),
+			humanize.IBytes(uint64(member.StageBytes)),
+			humanize.IBytes(uint64(member.CacheBytes)),
+			humanize.IBytes(member.UsedBytes),
+			humanize.IBytes(member.FreeBytes),
+			fmt.Sprintf("%.0f", member.Ops),
+			fmt.Sprintf("%.2fms", member.LatencyMs),
+			balance)
+		table.Append(row)
+	}
+	table.Render()
+	return buffer.String()
+}
+
+func runStats(cmd *cobra.Command, dingocli *cli.DingoCli, options statsOptions) error {
+	if options.interval <= 0 {
+		return errno.ERR_INVALID_REFRESH_INTERVAL.F("interval: %s", options.interval)
+	}
+
+	// 1) list cache members
+	cacheMembers, err := rpc.ListCacheMembers(cmd, options.group)
+	if err != nil {
+		return err
+	}
+	members := []*memberStats{}
+	for _, member := range cacheMembers {
+		if len(member.GetIp()) == 0 || member.GetPort() == 0 {
+			continue
+		}
+		members = append(members, &memberStats{
+			MemberId: member.GetMemberId(),
+			Group:    member.GetGroupName(),
+			Endpoint: fmt.Sprintf("%s:%d", member.GetIp(), member.GetPort()),
+			Weight:   member.GetWeight(),
+			State:    utils.TranslateCacheGroupMemberState(member.GetState()),
+			ip:       member.GetIp(),
+			port:     member.GetPort(),
+		})
+	}
+	if len(members) == 0 {
+		dingocli.WriteOutln("no cachemember in cluster")
+		return nil
+	}
+	sort.SliceStable(members, func(i, j int) bool { return members[i].Group < members[j].Group })
+
+	// 2) sample members every interval and show the statistics between two samples
+	client := &http.Client{Timeout: CACHE_STATS_TIMEOUT}
+	clear := isatty.IsTerminal(os.Stdout.Fd()) && options.count != 1 && options.format != "json"
+	last := sampleMembers(client, members)
+	for i := uint32(0); options.count == 0 || i < options.count; i++ {
+		time.Sleep(options.interval)
+		current := sampleMembers(client, members)
+		for k, member := range members {
+			member.reset()
+			if current[k].err != nil {
+				member.Error = current[k].err.Error()
+			} else if last[k].err == nil {
+				calcMemberStats(member, last[k].vars, current[k].vars, current[k].at.Sub(last[k].at))
+			}
+		}
+		markBalance(members, options.threshold)
+		last = current
+
+		if options.format == "json" { // one json document every interval
+			if err := output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: members}); err != nil {
+				return err
+			}
+			continue
+		} else if clear {
+			dingocli.WriteOut(ANSI_CLEAR_SCREEN)
+		}
+		dingocli.WriteOutln("%s (every %s)", time.Now().Format("2006-01-02 15:04:05"), options.interval)
+		dingocli.WriteOut("%s", renderStats(members))
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/common"
+)
+
+/*
+ * TestRpcStats, run: go test ./cli/command/cache/member -run ^TestRpcStats$
+ */
+func TestRpcStats(t *testing.T) {
+	vars := map[string]float64{
+		"rpc_server_9301_block_cache_service_range_count":   100,
+		"rpc_server_9301_block_cache_service_range_qps":     30,
+		"rpc_server_9301_block_cache_service_range_latency": 100,
+		"rpc_server_9301_block_cache_service_put_count":     50,
+		"rpc_server_9301_block_cache_service_put_qps":       10,
+		"rpc_server_9301_block_cache_service_put_latency":   500,
+		"rpc_server_9301_block_cache_service_ping_count":    1000, // not a block cache method
+		"process_cpu_usage": 0.5,
+	}
+	count, latency := rpcStats(vars)
+	if count != 150 {
+		t.Errorf("rpcStats() count = %v, expect 150", count)
+	}
+	// (100*30 + 500*10) / (30+10)
+	if latency != 200 {
+		t.Errorf("rpcStats() latency = %v, expect 200", latency)
+	}
+
+	count, latency = rpcStats(map[string]float64{})
+	if count != 0 || latency != 0 {
+		t.Errorf("rpcStats() of empty vars = (%v, %v), expect (0, 0)", count, latency)
+	}
+}
+
+/*
+ * TestCalcMemberStats, run: go test ./cli/command/cache/member -run ^TestCalcMemberStats$
+ */
+func TestCalcMemberStats(t *testing.T) {
+	left := map[string]float64{
+		"dingofs_disk_cache_group_hit_count":              100,
+		"dingofs_disk_cache_group_miss_count":             100,
+		"dingofs_disk_cache_group_load_total_bytes":       1000,
+		"dingofs_disk_cache_group_stage_total_bytes":      0,
+		"dingofs_disk_cache_group_cache_total_bytes":      0,
+		"rpc_server_9301_block_cache_service_range_count": 100,
+	}
+	right := map[string]float64{
+		"dingofs_disk_cache_group_hit_count":                100 + 30,
+		"dingofs_disk_cache_group_miss_count":               100 + 10,
+		"dingofs_disk_cache_group_load_total_bytes":         1000 + 2048,
+		"dingofs_disk_cache_group_stage_total_bytes":        4096,
+		"dingofs_disk_cache_group_cache_total_bytes":        1024,
+		"dingofs_disk_cache_group_used_bytes":               300,
+		"dingofs_disk_cache_group_capacity_bytes":           1000,
+		"rpc_server_9301_block_cache_service_range_count":   100 + 40,
+		"rpc_server_9301_block_cache_service_range_qps":     20,
+		"rpc_server_9301_block_cache_service_range_latency": 1500,
+	}
+
+	stats := &memberStats{}
+	calcMemberStats(stats, left, right, 2*time.Second)
+	expect := memberStats{
+		HitRatio:   0.75,
+		LoadBytes:  1024,
+		StageBytes: 2048,
+		CacheBytes: 512,
+		UsedBytes:  300,
+		FreeBytes:  700,
+		Ops:        20,
+		LatencyMs:  1.5,
+		sampled:    true,
+	}
+	if *stats != expect {
+		t.Errorf("calcMemberStats() = %+v, expect %+v", *stats, expect)
+	}
+
+	// used bytes is larger than capacity (e.g. capacity is shrinked)
+	right["dingofs_disk_cache_group_capacity_bytes"] = 100
+	stats = &memberStats{}
+	calcMemberStats(stats, left, right, time.Second)
+	if stats.FreeBytes != 0 {
+		t.Errorf("calcMemberStats() free bytes = %v, expect 0", stats.FreeBytes)
+	}
+
+	// samples are read at the same time, no rate can be calculated
+	stats = &memberStats{}
+	calcMemberStats(stats, left, right, 0)
+	if stats.sampled || stats.LoadBytes != 0 {
+		t.Errorf("calcMemberStats() with zero elapsed = %+v, expect not sampled", *stats)
+	}
+}
+
+/*
+ * TestMarkBalance, run: go test ./cli/command/cache/member -run ^TestMarkBalance$
+ */
+func TestMarkBalance(t *testing.T) {
+	member := func(group string, weight uint32, load float64) *memberStats {
+		return &memberStats{Group: group, Weight: weight, LoadBytes: load, sampled: true}
+	}
+	tests := []struct {
+		name    string
+		members []*memberStats
+		expect  []string
+	}{
+		{
+			"balanced",
+			[]*memberStats{member("g1", 100, 100), member("g1", 200, 200)},
+			[]string{BALANCE_OK, BALANCE_OK},
+		},
+		{
+			"overload and underload",
+			[]*memberStats{member("g1", 100, 300), member("g1", 100, 100)},
+			[]string{BALANCE_OVERLOAD + "(+50%)", BALANCE_UNDERLOAD + "(-50%)"},
+		},
+		{
+			"groups are compared separately",
+			[]*memberStats{member("g1", 100, 100), member("g2", 100, 1000), member("g2", 100, 1000)},
+			[]string{BALANCE_OK, BALANCE_OK, BALANCE_OK},
+		},
+		{
+			"zero weight member is excluded",
+			[]*memberStats{member("g1", 0, 100), member("g1", 100, 100), member("g1", 100, 100)},
+			[]string{common.ROW_VALUE_NO_VALUE, BALANCE_OK, BALANCE_OK},
+		},
+		{
+			"unreachable member is excluded",
+			[]*memberStats{{Group: "g1", Weight: 100, Error: "timeout"}, member("g1", 100, 100), member("g1", 100, 100)},
+			[]string{common.ROW_VALUE_NO_VALUE, BALANCE_OK, BALANCE_OK},
+		},
+		{
+			"member without two samples is excluded",
+			[]*memberStats{{Group: "g1", Weight: 100}, member("g1", 100, 100), member("g1", 100, 100)},
+			[]string{common.ROW_VALUE_NO_VALUE, BALANCE_OK, BALANCE_OK},
+		},
+		{
+			"no load",
+			[]*memberStats{member("g1", 100, 0), member("g1", 100, 0)},
+			[]string{common.ROW_VALUE_NO_VALUE, common.ROW_VALUE_NO_VALUE},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markBalance(tt.members, 0.2)
+			for i, member := range tt.members {
+				if member.Balance != tt.expect[i] {
+					t.Errorf("member %d: balance = %s, expect %s", i, member.Balance, tt.expect[i])
+				}
+			}
+		})
+	}
+}
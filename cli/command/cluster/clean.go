@@ -17,6 +17,8 @@
 package cluster
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure/topology"
@@ -57,6 +59,7 @@ type cleanOptions struct {
 	only           []string
 	withoutRecycle bool
 	force          bool
+	timeout        time.Duration
 }
 
 func checkCleanOptions(dingocli *cli.DingoCli, options cleanOptions) error {
@@ -94,6 +97,7 @@ func NewCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringSliceVarP(&options.only, "only", "o", CLEAN_ITEMS, "Specify clean item")
 	flags.BoolVar(&options.withoutRecycle, "no-recycle", false, "Remove data directory directly instead of recycle chunks")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -158,6 +162,9 @@ func genCleanPlaybook(dingocli *cli.DingoCli,
 				comm.KEY_CLEAN_ITEMS:      options.only,
 				comm.KEY_CLEAN_BY_RECYCLE: options.withoutRecycle == false,
 			},
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
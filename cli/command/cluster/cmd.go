@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/cluster/cores"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -38,6 +39,10 @@ func NewClusterCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewRemoveCommand(dingocli),
 		NewRenameCommand(dingocli),
 		NewStatusCommand(dingocli),
+		NewTopCommand(dingocli),
+		NewLogsCommand(dingocli),
+		NewSupportBundleCommand(dingocli),
+		cores.NewCoresCommand(dingocli),
 		NewStartCommand(dingocli),
 		NewStopCommand(dingocli),
 		NewRestartCommand(dingocli),
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package cores
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FETCH_EXAMPLE = `Examples:
+  $ dingo cluster cores fetch 3b5e2c9a1f0d            # Download core file and its binary into current directory
+  $ dingo cluster cores fetch 3b5e2c9a1f0d -o /tmp/c  # Download core file and its binary into /tmp/c`
+)
+
+type fetchOptions struct {
+	id     string
+	output string
+}
+
+func NewFetchCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options fetchOptions
+
+	cmd := &cobra.Command{
+		Use:     "fetch ID [OPTIONS]",
+		Short:   "Download core file with its binary for debugging",
+		Args:    cliutil.ExactArgs(1),
+		Example: FETCH_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.id = args[0]
+			return runFetch(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.output, "output", "o", ".", "Specify the directory to save core file and binary")
+
+	return cmd
+}
+
+func genFetchPlaybook(dingocli *cli.DingoCli, core task.CoreFile, dir string) (*playbook.Playbook, error) {
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return nil, err
+	}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   "*",
+		Role: "*", // the owner of core file may be unknown
+		Host: core.Host,
+	})
+	if len(dcs) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.FETCH_CORE_FILE,
+		Configs: dcs[:1], // only fetch once
+		Options: map[string]interface{}{
+			comm.KEY_FETCH_CORE_FILE: core,
+			comm.KEY_FETCH_CORE_DIR:  dir,
+		},
+	})
+	return pb, nil
+}
+
+func runFetch(dingocli *cli.DingoCli, options fetchOptions) error {
+	// 1) find the core file by id
+	cores, err := listCoreFiles(dingocli, "*", "*")
+	if err != nil {
+		return err
+	}
+	var core *task.CoreFile
+	for i := range cores {
+		if cores[i].Id == options.id {
+			core = &cores[i]
+			break
+		}
+	}
+	if core == nil {
+		return errno.ERR_CORE_FILE_NOT_FOUND.F("id: %s", options.id)
+	}
+
+	// 2) download core file and binary
+	dir, err := filepath.Abs(options.output)
+	if err != nil {
+		return errno.ERR_CREATE_DIRECTORY_FAILED.E(err)
+	} else if err := os.MkdirAll(dir, 0755); err != nil {
+		return errno.ERR_CREATE_DIRECTORY_FAILED.E(err)
+	}
+	pb, err := genFetchPlaybook(dingocli, *core, dir)
+	if err != nil {
+		return err
+	} else if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 3) print the hint for gdb
+	coreFile := filepath.Join(dir, path.Base(core.Path))
+	dingocli.WriteOutln("")
+	if core.Binary == task.CORE_BINARY_UNKNOWN {
+		dingocli.WriteOutln("Core file saved to %s", color.GreenString(coreFile))
+		dingocli.WriteOutln(color.YellowString("Binary of the core file is unknown, please copy it from image %s manually", core.Image))
+		return nil
+	} else if !core.HasBinary() {
+		dingocli.WriteOutln("Core file saved to %s", color.GreenString(coreFile))
+		dingocli.WriteOutln(color.YellowString("Image of the core file is unknown, please copy %s from the image of crashed service manually", core.Binary))
+		return nil
+	}
+	binary := filepath.Join(dir, path.Base(core.Binary))
+	dingocli.WriteOutln("Core file and binary saved to %s", color.GreenString(dir))
+	dingocli.WriteOutln("Debug it by: %s", color.GreenString("gdb %s %s", binary, coreFile))
+	return nil
+}
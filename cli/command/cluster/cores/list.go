@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package cores
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/service"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LIST_EXAMPLE = `Examples:
+  $ dingo cluster cores list                  # List core files of all services
+  $ dingo cluster cores list --role mds       # List core files of mds services
+  $ dingo cluster cores list --host server-1  # List core files of services on host 'server-1'`
+)
+
+type listOptions struct {
+	role string
+	host string
+}
+
+func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Aliases: []string{"ls"},
+		Short:   "List core files of services",
+		Args:    cliutil.NoArgs,
+		Example: LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+
+	return cmd
+}
+
+// listCoreFiles lists core files of matched services and sorts them by time
+func listCoreFiles(dingocli *cli.DingoCli, role, host string) ([]task.CoreFile, error) {
+	all, err := dingocli.ParseTopology()
+	if err != nil {
+		return nil, err
+	}
+	dcs := dingocli.FilterDeployConfig(all, topology.FilterOption{
+		Id:   "*",
+		Role: role,
+		Host: host,
+	})
+	if len(dcs) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	// all services are required to match the owner of core files in shared directory
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.LIST_CORE_FILES,
+		Configs: dcs,
+		Options: map[string]interface{}{
+			comm.KEY_ALL_DEPLOY_CONFIGS: all,
+		},
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+		},
+	})
+	if err := pb.Run(); err != nil {
+		return nil, err
+	}
+
+	cores := []task.CoreFile{}
+	value := dingocli.MemStorage().Get(comm.KEY_ALL_CORE_FILES)
+	if value != nil {
+		for _, core := range value.(map[string]task.CoreFile) {
+			// the core file which owner unknown is listed for any role
+			if role == "*" || core.Role == role || core.Role == task.CORE_OWNER_UNKNOWN {
+				cores = append(cores, core)
+			}
+		}
+	}
+	tui.SortCoreFiles(cores)
+	return cores, nil
+}
+
+func runList(dingocli *cli.DingoCli, options listOptions) error {
+	cores, err := listCoreFiles(dingocli, options.role, options.host)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatCoreFiles(cores))
+	return nil
+}
@@ -162,6 +162,7 @@ type deployOptions struct {
 	poolset         string
 	poolsetDiskType string
 	useLocalImage   bool
+	timeout         time.Duration
 }
 
 func checkDeployOptions(options deployOptions) error {
@@ -197,6 +198,7 @@ func NewDeployCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.poolset, "poolset", "default", "Specify the poolset name")
 	flags.StringVar(&options.poolsetDiskType, "poolset-disktype", "ssd", "Specify the disk type of physical pool")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each deploy step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -229,8 +231,9 @@ func precheckBeforeDeploy(dingocli *cli.DingoCli,
 		return nil
 	}
 
-	// 2) generate precheck playbook
-	pb, err := genPrecheckPlaybook(dingocli, dcs, precheckOptions{})
+	// 2) generate precheck playbook, only the builtin check items are checked,
+	//    the checkers in registry are audited by 'cluster precheck' explicitly
+	pb, err := genPrecheckPlaybook(dingocli, dcs, precheckOptions{onlyChecks: CHECK_ITEMS, timeout: options.timeout})
 	if err != nil {
 		return err
 	}
@@ -304,13 +307,13 @@ func genDeployPlaybook(dingocli *cli.DingoCli,
 			config = config[:n]
 		}
 
-		// bs options
-		options := map[string]interface{}{}
-
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: config,
-			Options: options,
+			Options: map[string]interface{}{},
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
@@ -0,0 +1,487 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LOGS_EXAMPLE = `Examples:
+  $ dingo cluster logs                             # Display the last 100 lines of logs for all services
+  $ dingo cluster logs --role mds --since 30m      # Display logs of mds services in the last 30 minutes
+  $ dingo cluster logs --host server1 --follow     # Follow logs of services on host 'server1'
+  $ dingo cluster logs --grep 'ERROR|WARN'         # Display logs which match the pattern`
+
+	DEFAULT_LOGS_TAIL = 100
+
+	LOG_SOURCE_CONTAINER = "container"
+	LOG_SOURCE_FILE      = "file"
+
+	/*
+	 * AWK_FILTER_SINCE filters the lines of log files on host, only the lines
+	 * since -v since=YYYYMMDDhhmmss are sent back. the time formats are same as
+	 * REGEX_GLOG_TIME and REGEX_ISO_TIME, and the line without time (e.g. stack)
+	 * follows the last line which has time in the same file, the files are
+	 * separated by the headers of tail (==> file <==). glog doesn't record the
+	 * year, which is taken from the last line (or since for the first line) and
+	 * rolls forward when the month goes backwards, the first line later than
+	 * -v until=YYYYMMDDhhmmss belongs to last year. it's POSIX awk (e.g. mawk),
+	 * so no interval expression like [0-9]{2} is used.
+	 */
+	AWK_FILTER_SINCE = `BEGIN {
+  d = "[0-9][0-9]"; hms = " " d ":" d ":" d
+  glog8 = "^[IWEF]" d d d d hms; glog4 = "^[IWEF]" d d hms
+  iso = "^\\[?" d d "-" d "-" d "[T ]" d ":" d ":" d
+}
+/^==> .* <==$/ { last[file] = t; file = $0; t = last[file]; blank = 0; next }
+$0 == "" { blank++; next }
+{
+  k = ""
+  if ($0 ~ glog8) {
+    k = substr($0, 2, 8) substr($0, 11, 2) substr($0, 14, 2) substr($0, 17, 2)
+  } else if ($0 ~ glog4) {
+    p = (t != "") ? t : since; y = substr(p, 1, 4)
+    if (substr($0, 2, 2) + 1 < substr(p, 5, 2) + 0) y++
+    k = y substr($0, 2, 4) substr($0, 7, 2) substr($0, 10, 2) substr($0, 13, 2)
+    if (t == "" && k > until) k = (y - 1) substr(k, 5)
+  } else if ($0 ~ iso) {
+    o = (substr($0, 1, 1) == "[") ? 1 : 0
+    k = substr($0, 1+o, 4) substr($0, 6+o, 2) substr($0, 9+o, 2) substr($0, 12+o, 2) substr($0, 15+o, 2) substr($0, 18+o, 2)
+  }
+  if (k != "") t = k
+  if (t != "" && t >= since) {
+    for (; blank > 0; blank--) print ""
+    print; fflush()
+  }
+  blank = 0
+}`
+)
+
+var (
+	// glog: I20260102 15:04:05.000000 or I0102 15:04:05.000000
+	REGEX_GLOG_TIME = regexp.MustCompile(`^[IWEF](\d{8}|\d{4}) (\d{2}:\d{2}:\d{2}\.\d+)`)
+	// others: 2026-01-02 15:04:05,000 or [2026-01-02T15:04:05.000]
+	REGEX_ISO_TIME = regexp.MustCompile(`^\[?(\d{4}-\d{2}-\d{2})[T ](\d{2}:\d{2}:\d{2})(?:[.,](\d+))?`)
+
+	LOGS_SERVICE_COLORS = []color.Attribute{
+		color.FgGreen,
+		color.FgYellow,
+		color.FgBlue,
+		color.FgMagenta,
+		color.FgCyan,
+		color.FgHiGreen,
+		color.FgHiYellow,
+		color.FgHiBlue,
+		color.FgHiMagenta,
+		color.FgHiCyan,
+	}
+)
+
+type (
+	logsOptions struct {
+		id     string
+		role   string
+		host   string
+		since  string
+		follow bool
+		grep   string
+		tail   int
+	}
+
+	logLine struct {
+		time time.Time
+		text string
+	}
+
+	// one log stream of service, container logs or files under log_dir
+	logSource struct {
+		dc        *topology.DeployConfig
+		serviceId string
+		kind      string
+		prefix    string
+		lines     []logLine
+		last      time.Time // the time of last line which has timestamp
+	}
+
+	// lineWriter splits the stream into lines, both stdout and stderr
+	// of the remote command write into it concurrently
+	lineWriter struct {
+		mutex  sync.Mutex
+		buffer []byte
+		handle func(string)
+	}
+)
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.buffer = append(w.buffer, p...)
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			break
+		}
+		w.handle(strings.TrimRight(string(w.buffer[:i]), "\r"))
+		w.buffer = w.buffer[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *lineWriter) Flush() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if len(w.buffer) > 0 {
+		w.handle(string(w.buffer))
+		w.buffer = nil
+	}
+}
+
+func NewLogsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options logsOptions
+
+	cmd := &cobra.Command{
+		Use:     "logs [OPTIONS]",
+		Short:   "Display logs of services",
+		Args:    cliutil.NoArgs,
+		Example: LOGS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// display all logs in the time range if user specified --since only
+			if len(options.since) > 0 && !cmd.Flags().Changed("tail") {
+				options.tail = 0
+			}
+			return runLogs(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.id, "id", "*", "Specify service id")
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVar(&options.since, "since", "", "Show logs since timestamp (e.g. 2026-01-02T15:04:05) or relative (e.g. 30m)")
+	flags.BoolVarP(&options.follow, "follow", "f", false, "Follow log output")
+	flags.StringVar(&options.grep, "grep", "", "Only display lines which match the regular expression")
+	flags.IntVarP(&options.tail, "tail", "n", DEFAULT_LOGS_TAIL, "Number of lines to show from the end of each log, 0 means all")
+
+	return cmd
+}
+
+// return the start time and the value for 'docker logs --since'
+func parseSince(since string, now time.Time) (time.Time, string, error) {
+	if len(since) == 0 {
+		return time.Time{}, "", nil
+	}
+
+	if d, err := time.ParseDuration(since); err == nil && d > 0 {
+		return now.Add(-d), since, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, since, time.Local); err == nil {
+			return t, strconv.FormatInt(t.Unix(), 10), nil
+		}
+	}
+	return time.Time{}, "", errno.ERR_INVALID_LOGS_SINCE.F("since: %s", since)
+}
+
+/*
+ * parseLogTime parses the time of log line, glog doesn't record the year,
+ * so it's taken from the last line and rolls forward when the month goes
+ * backwards (the lines of threads may be out of order a little), and the
+ * line later than one day after now belongs to last year.
+ */
+func parseLogTime(text string, last, now time.Time) (time.Time, bool) {
+	if mu := REGEX_GLOG_TIME.FindStringSubmatch(text); len(mu) > 0 {
+		date := mu[1]
+		guess := len(date) == 4
+		if guess { // glog doesn't record the year
+			year := now.Year()
+			if !last.IsZero() {
+				year = last.Year()
+				if month, _ := strconv.Atoi(date[:2]); month+1 < int(last.Month()) {
+					year++
+				}
+			}
+			date = strconv.Itoa(year) + date
+		}
+		t, err := time.ParseInLocation("20060102 15:04:05", date+" "+mu[2], time.Local)
+		if err == nil && guess && t.After(now.AddDate(0, 0, 1)) {
+			t = t.AddDate(-1, 0, 0)
+		}
+		return t, err == nil
+	} else if mu := REGEX_ISO_TIME.FindStringSubmatch(text); len(mu) > 0 {
+		value := mu[1] + "T" + mu[2]
+		if len(mu[3]) > 0 {
+			value += "." + mu[3]
+		}
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", value, time.Local)
+		return t, err == nil
+	}
+	return time.Time{}, false
+}
+
+func getLogSources(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) ([]*logSource, error) {
+	sources := []*logSource{}
+	for i, dc := range dcs {
+		serviceId := dingocli.GetServiceId(dc.GetId())
+		decorate := color.New(LOGS_SERVICE_COLORS[i%len(LOGS_SERVICE_COLORS)]).SprintFunc()
+		prefix := decorate(fmt.Sprintf("%s %s", dc.GetRole(), serviceId))
+
+		containerId, err := dingocli.GetContainerId(serviceId)
+		if err != nil {
+			return nil, err
+		} else if containerId != comm.CLEANED_CONTAINER_ID {
+			sources = append(sources, &logSource{
+				dc:        dc,
+				serviceId: serviceId,
+				kind:      LOG_SOURCE_CONTAINER,
+				prefix:    prefix,
+			})
+		}
+
+		if len(dc.GetLogDir()) > 0 {
+			sources = append(sources, &logSource{
+				dc:        dc,
+				serviceId: serviceId,
+				kind:      LOG_SOURCE_FILE,
+				prefix:    prefix,
+			})
+		}
+	}
+	return sources, nil
+}
+
+/*
+ * glog writes the WARNING/ERROR/FATAL logs into INFO file too,
+ * so we skip them to avoid duplicate lines. with since, the lines
+ * are filtered on host to avoid sending the whole files over SSH.
+ */
+func tailLogFilesCommand(logDir string, tail int, follow bool, since, now time.Time) string {
+	find := fmt.Sprintf("find %s -maxdepth 1 -type f"+
+		" ! -name \"*WARNING*\" ! -name \"*ERROR*\" ! -name \"*FATAL*\"", logDir)
+	if !since.IsZero() {
+		find += fmt.Sprintf(" -newermt @%d", since.Unix())
+	}
+
+	lines := "+1"
+	if tail > 0 {
+		lines = strconv.Itoa(tail)
+	}
+	tailCmd := fmt.Sprintf("tail -n %s", lines)
+	if since.IsZero() {
+		tailCmd += " -q" // the headers are required to filter lines of each file
+	}
+	if follow {
+		tailCmd += " -F"
+	}
+	command := fmt.Sprintf("%s -print0 | xargs -0 -r %s", find, tailCmd)
+	if !since.IsZero() {
+		local := since.In(time.Local)
+		command += fmt.Sprintf(" | awk -v since=%s -v until=%s '%s'", local.Format("20060102150405"),
+			now.AddDate(0, 0, 1).In(time.Local).Format("20060102150405"), AWK_FILTER_SINCE)
+	}
+	return fmt.Sprintf("bash -c '%s'", strings.ReplaceAll(command, "'", `'\''`))
+}
+
+func streamLogs(ctx context.Context, dingocli *cli.DingoCli, source *logSource,
+	options logsOptions, since time.Time, sinceArg string, writer *lineWriter) error {
+	hc, err := dingocli.GetHost(source.dc.GetHost())
+	if err != nil {
+		return err
+	}
+
+	pool := module.GlobalSSHPool()
+	client, err := pool.Get(*hc.GetSSHConfig())
+	if err != nil {
+		return errno.ERR_SSH_CONNECT_FAILED.E(err)
+	}
+	defer func() {
+		if ctx.Err() != nil { // make sure the remote command exits
+			pool.Evict(client)
+		} else {
+			pool.Put(client)
+		}
+	}()
+
+	m := module.NewModule(ctx, client)
+	if source.kind == LOG_SOURCE_FILE {
+		command := tailLogFilesCommand(source.dc.GetLogDir(), options.tail, options.follow, since, time.Now())
+		return m.Shell().Command(command).Stream(dingocli.ExecOptions(), writer)
+	}
+
+	containerId, err := dingocli.GetContainerId(source.serviceId)
+	if err != nil {
+		return err
+	}
+	cli := m.DockerCli().ContainerLogs(containerId).AddOption("--timestamps")
+	if options.tail > 0 {
+		cli.AddOption("--tail %d", options.tail)
+	}
+	if len(sinceArg) > 0 {
+		cli.AddOption("--since %s", sinceArg)
+	}
+	if options.follow {
+		cli.AddOption("--follow")
+	}
+	return cli.Stream(dingocli.ExecOptions(), writer)
+}
+
+func runLogs(dingocli *cli.DingoCli, options logsOptions) error {
+	// 1) parse options
+	now := time.Now()
+	since, sinceArg, err := parseSince(options.since, now)
+	if err != nil {
+		return err
+	}
+	var pattern *regexp.Regexp
+	if len(options.grep) > 0 {
+		pattern, err = regexp.Compile(options.grep)
+		if err != nil {
+			return errno.ERR_BUILD_REGEX_FAILED.E(err)
+		}
+	}
+
+	// 2) parse cluster topology and filter services
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   options.id,
+		Role: options.role,
+		Host: options.host,
+	})
+	if len(dcs) == 0 {
+		return errno.ERR_NO_SERVICES_MATCHED
+	}
+	sources, err := getLogSources(dingocli, dcs)
+	if err != nil {
+		return err
+	}
+
+	// 3) prepare SSH secrets before streaming, the prompt will mess the output
+	for _, dc := range dcs {
+		hc, err := dingocli.GetHost(dc.GetHost())
+		if err != nil {
+			return err
+		} else if err := hc.GetSSHConfig().Prepare(); err != nil {
+			return err
+		}
+	}
+
+	// 4) stream logs of all services in parallel
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var outMutex sync.Mutex
+	var wg sync.WaitGroup
+	failed := []string{}
+	for _, source := range sources {
+		source.last = since
+		writer := &lineWriter{handle: func(text string) {
+			if source.kind == LOG_SOURCE_CONTAINER {
+				// the timestamp added by 'docker logs --timestamps'
+				i := strings.IndexByte(text, ' ')
+				if t, err := time.Parse(time.RFC3339Nano, text[:max(i, 0)]); err == nil {
+					source.last = t
+					text = text[i+1:]
+				}
+			} else if t, ok := parseLogTime(text, source.last, time.Now()); ok {
+				source.last = t
+			}
+
+			// the line without timestamp (e.g. stack trace) belongs to previous line
+			if source.last.Before(since) {
+				return
+			} else if pattern != nil && !pattern.MatchString(text) {
+				return
+			}
+
+			if options.follow {
+				outMutex.Lock()
+				dingocli.WriteOutln("%s | %s", source.prefix, text)
+				outMutex.Unlock()
+			} else {
+				source.lines = append(source.lines, logLine{time: source.last, text: text})
+			}
+		}}
+
+		wg.Add(1)
+		go func(source *logSource) {
+			defer wg.Done()
+			err := streamLogs(ctx, dingocli, source, options, since, sinceArg, writer)
+			writer.Flush()
+			if err != nil && ctx.Err() == nil {
+				log.Error("Stream service logs",
+					log.Field("id", source.serviceId),
+					log.Field("source", source.kind),
+					log.Field("error", err))
+				outMutex.Lock()
+				failed = append(failed, fmt.Sprintf("%s(%s)", source.serviceId, source.kind))
+				outMutex.Unlock()
+			}
+		}(source)
+	}
+	wg.Wait()
+
+	// 5) merge the logs of all services by timestamp
+	if !options.follow {
+		type mergedLine struct {
+			logLine
+			prefix string
+		}
+		lines := []mergedLine{}
+		for _, source := range sources {
+			for _, line := range source.lines {
+				lines = append(lines, mergedLine{line, source.prefix})
+			}
+		}
+		sort.SliceStable(lines, func(i, j int) bool {
+			return lines[i].time.Before(lines[j].time)
+		})
+		for _, line := range lines {
+			dingocli.WriteOutln("%s | %s", line.prefix, line.text)
+		}
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return errno.ERR_GET_SERVICE_LOGS_FAILED.F("services: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+/*
+ * TestTailLogFilesCommand, run: go test ./cli/command/cluster -run ^TestTailLogFilesCommand$
+ */
+func TestTailLogFilesCommand(t *testing.T) {
+	if _, err := exec.LookPath("awk"); err != nil {
+		t.Skip("awk not found")
+	}
+
+	// the command is executed on host by SSH, here we run it locally
+	dir := t.TempDir()
+	files := map[string]string{
+		"mds.INFO": strings.Join([]string{
+			"I20260102 09:59:59.000000 12 old line",
+			"I20260102 10:00:00.000000 12 first line",
+			"    stack of first line",
+			"E0102 10:30:00.000000 12 line without year",
+		}, "\n") + "\n",
+		"mds.ERROR": "E20260102 10:30:00.000000 12 duplicate line\n",
+		"fs.INFO": strings.Join([]string{
+			"I1231 23:59:00.000000 13 line of last year",
+			"I0101 00:01:00.000000 13 line of new year",
+		}, "\n") + "\n",
+		"cache.log": strings.Join([]string{
+			"[2026-01-02T09:00:00.000] old iso line",
+			"    stack of old iso line",
+			"2026-01-02 11:00:00,000 iso line",
+		}, "\n") + "\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		tail   int
+		since  time.Time
+		expect []string
+	}{
+		{
+			"all lines",
+			0,
+			time.Time{},
+			[]string{
+				"    stack of first line",
+				"    stack of old iso line",
+				"2026-01-02 11:00:00,000 iso line",
+				"E0102 10:30:00.000000 12 line without year",
+				"I0101 00:01:00.000000 13 line of new year",
+				"I1231 23:59:00.000000 13 line of last year",
+				"I20260102 09:59:59.000000 12 old line",
+				"I20260102 10:00:00.000000 12 first line",
+				"[2026-01-02T09:00:00.000] old iso line",
+			},
+		},
+		{
+			"since",
+			0,
+			time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local),
+			[]string{
+				"    stack of first line",
+				"2026-01-02 11:00:00,000 iso line",
+				"E0102 10:30:00.000000 12 line without year",
+				"I20260102 10:00:00.000000 12 first line",
+			},
+		},
+		{
+			"since with tail",
+			2,
+			time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local),
+			[]string{ // the stack lines are dropped for the time of them is unknown
+				"2026-01-02 11:00:00,000 iso line",
+				"E0102 10:30:00.000000 12 line without year",
+			},
+		},
+		{
+			"since later than all",
+			0,
+			time.Date(2026, 1, 2, 12, 0, 0, 0, time.Local),
+			[]string{},
+		},
+		{
+			"since crosses year",
+			0,
+			time.Date(2025, 12, 31, 23, 0, 0, 0, time.Local),
+			[]string{
+				"    stack of first line",
+				"    stack of old iso line",
+				"2026-01-02 11:00:00,000 iso line",
+				"E0102 10:30:00.000000 12 line without year",
+				"I0101 00:01:00.000000 13 line of new year",
+				"I1231 23:59:00.000000 13 line of last year",
+				"I20260102 09:59:59.000000 12 old line",
+				"I20260102 10:00:00.000000 12 first line",
+				"[2026-01-02T09:00:00.000] old iso line",
+			},
+		},
+	}
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.Local)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := tailLogFilesCommand(dir, tt.tail, false, tt.since, now)
+			out, err := exec.Command("sh", "-c", command).CombinedOutput()
+			if err != nil {
+				t.Fatalf("run %s: %v\n%s", command, err, out)
+			}
+
+			lines := []string{}
+			for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+				if len(line) > 0 {
+					lines = append(lines, line)
+				}
+			}
+			sort.Strings(lines) // the order of files is unspecified
+			if !reflect.DeepEqual(lines, tt.expect) {
+				t.Errorf("tailLogFilesCommand() output:\n%s\nexpect:\n%s",
+					strings.Join(lines, "\n"), strings.Join(tt.expect, "\n"))
+			}
+		})
+	}
+}
+
+/*
+ * TestParseLogTime, run: go test ./cli/command/cluster -run ^TestParseLogTime$
+ */
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name   string
+		text   string
+		last   time.Time
+		expect time.Time
+		ok     bool
+	}{
+		{
+			"glog with year",
+			"I20251231 23:59:00.000000 12 line",
+			time.Time{},
+			time.Date(2025, 12, 31, 23, 59, 0, 0, time.Local),
+			true,
+		},
+		{
+			"year of now",
+			"I0102 10:00:00.000000 12 line",
+			time.Time{},
+			time.Date(2026, 1, 2, 10, 0, 0, 0, time.Local),
+			true,
+		},
+		{
+			"later than now",
+			"I1231 23:59:00.000000 12 line",
+			time.Time{},
+			time.Date(2025, 12, 31, 23, 59, 0, 0, time.Local),
+			true,
+		},
+		{
+			"year of last line",
+			"I1231 23:59:30.000000 12 line",
+			time.Date(2025, 12, 31, 23, 59, 0, 0, time.Local),
+			time.Date(2025, 12, 31, 23, 59, 30, 0, time.Local),
+			true,
+		},
+		{
+			"month goes backwards",
+			"I0101 00:01:00.000000 12 line",
+			time.Date(2025, 12, 31, 23, 59, 0, 0, time.Local),
+			time.Date(2026, 1, 1, 0, 1, 0, 0, time.Local),
+			true,
+		},
+		{
+			"out of order",
+			"I0131 23:59:59.000000 12 line",
+			time.Date(2025, 2, 1, 0, 0, 0, 0, time.Local),
+			time.Date(2025, 1, 31, 23, 59, 59, 0, time.Local),
+			true,
+		},
+		{
+			"iso",
+			"[2026-01-02T09:00:00.000] line",
+			time.Time{},
+			time.Date(2026, 1, 2, 9, 0, 0, 0, time.Local),
+			true,
+		},
+		{"no time", "    stack", time.Time{}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseLogTime(tt.text, tt.last, now)
+			if ok != tt.ok {
+				t.Fatalf("parseLogTime(%q) ok = %v, expect %v", tt.text, ok, tt.ok)
+			}
+			if !got.Equal(tt.expect) {
+				t.Errorf("parseLogTime(%q) = %v, expect %v", tt.text, got, tt.expect)
+			}
+		})
+	}
+}
@@ -19,12 +19,15 @@ package cluster
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure/topology"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/task/task/checker"
+	"github.com/dingodb/dingocli/internal/tui"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	utils "github.com/dingodb/dingocli/internal/utils"
 	"github.com/fatih/color"
@@ -35,7 +38,10 @@ const (
 	PRECHECK_EXAMPLE = `Examples:
   $ dingocli cluster precheck                         # Check all items
   $ dingocli cluster precheck --skip topology         # Check all items except topology
-  $ dingocli cluster precheck --skip topology,kernel  # Check all items except topology and kernel`
+  $ dingocli cluster precheck --skip topology,kernel  # Check all items except topology and kernel
+  $ dingocli cluster precheck --skip-check ntp        # Check all items except NTP offset
+  $ dingocli cluster precheck --only-check memory,fd  # Only check free memory and file descriptors
+  $ dingocli cluster precheck --report precheck.html  # Check all items and export the report`
 )
 
 const (
@@ -53,6 +59,7 @@ var (
 		playbook.CHECK_TOPOLOGY,             // topology
 		playbook.CHECK_SSH_CONNECT,          // ssh
 		playbook.CHECK_PERMISSION,           // permission
+		playbook.GET_KERNEL_RELEASE,         // kernel, only for report
 		playbook.CLEAN_PRECHECK_ENVIRONMENT, // <none>
 		playbook.CHECK_PORT_IN_USE,          // network
 		playbook.START_HTTP_SERVER,
@@ -60,6 +67,7 @@ var (
 		// playbook.CHECK_NETWORK_FIREWALL,
 		playbook.GET_HOST_DATE, // date
 		playbook.CHECK_HOST_DATE,
+		playbook.CHECK_SYSTEM, // checkers in registry
 	}
 
 	PRECHECK_POST_STEPS = []int{
@@ -71,7 +79,9 @@ var (
 		playbook.CHECK_SSH_CONNECT:           CHECK_ITEM_SSH,
 		playbook.CHECK_PERMISSION:            CHECK_ITEM_PERMISSION,
 		playbook.CHECK_KERNEL_VERSION:        CHECK_ITEM_KERNEL,
+		playbook.GET_KERNEL_RELEASE:          CHECK_ITEM_KERNEL,
 		playbook.CHECK_PORT_IN_USE:           CHECK_ITEM_NERWORK,
+		playbook.START_HTTP_SERVER:           CHECK_ITEM_NERWORK,
 		playbook.CHECK_DESTINATION_REACHABLE: CHECK_ITEM_NERWORK,
 		playbook.CHECK_NETWORK_FIREWALL:      CHECK_ITEM_NERWORK,
 		playbook.GET_HOST_DATE:               CHECK_ITEM_DATE,
@@ -92,8 +102,16 @@ var (
 
 type precheckOptions struct {
 	skip          []string
+	skipChecks    []string
+	onlyChecks    []string
 	useLocalImage bool
-	//only              []string
+	report        string
+	timeout       time.Duration
+}
+
+// all check names: the builtin check items and the checkers in registry
+func getCheckNames() []string {
+	return append(append([]string{}, CHECK_ITEMS...), checker.GetCheckerNames()...)
 }
 
 func checkPrecheckOptions(options precheckOptions) error {
@@ -103,7 +121,30 @@ func checkPrecheckOptions(options precheckOptions) error {
 			return errno.ERR_UNSUPPORT_SKIPPED_CHECK_ITEM
 		}
 	}
-	return nil
+
+	supported = utils.Slice2Map(getCheckNames())
+	for _, name := range append(options.skipChecks, options.onlyChecks...) {
+		if !supported[name] {
+			return errno.ERR_UNSUPPORT_CHECK_NAME.F("check: %s", name)
+		}
+	}
+	return checkReportFormat(options.report)
+}
+
+// selectChecks returns the enabled checks: --only-check first, then --skip and --skip-check
+func selectChecks(options precheckOptions) map[string]bool {
+	selected := map[string]bool{}
+	names := getCheckNames()
+	if len(options.onlyChecks) > 0 {
+		names = options.onlyChecks
+	}
+	for _, name := range names {
+		selected[name] = true
+	}
+	for _, name := range append(options.skip, options.skipChecks...) {
+		delete(selected, name)
+	}
+	return selected
 }
 
 func NewPrecheckCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -126,15 +167,19 @@ func NewPrecheckCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags := cmd.Flags()
 	usage := fmt.Sprintf("Specify skipped check item (%s)", strings.Join(CHECK_ITEMS, ","))
 	flags.StringSliceVar(&options.skip, "skip", []string{}, usage)
+	names := strings.Join(getCheckNames(), ",")
+	flags.StringSliceVar(&options.skipChecks, "skip-check", []string{}, fmt.Sprintf("Specify skipped checks (%s)", names))
+	flags.StringSliceVar(&options.onlyChecks, "only-check", []string{}, fmt.Sprintf("Only run specified checks (%s)", names))
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
-	//flags.StringSliceVar(&options.only, "only", CHECK_ITEMS, usage)
+	flags.StringVar(&options.report, "report", "", "Export the precheck report to file (*.json or *.html)")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
 
 func skipPrecheckSteps(precheckSteps []int, options precheckOptions) []int {
 	out := []int{}
-	skipped := utils.Slice2Map(options.skip)
+	selected := selectChecks(options)
 
 	if options.useLocalImage {
 		// remove PULL_IMAGE step
@@ -147,7 +192,8 @@ func skipPrecheckSteps(precheckSteps []int, options precheckOptions) []int {
 	}
 
 	for _, step := range precheckSteps {
-		if skipped[BELONG_CHECK_ITEM[step]] {
+		item, ok := BELONG_CHECK_ITEM[step]
+		if ok && !selected[item] {
 			continue
 		}
 		out = append(out, step)
@@ -165,6 +211,13 @@ func genPrecheckPlaybook(dingocli *cli.DingoCli,
 	skipRoles := topology.FetchSkipRoles(kind, dcs, roles)
 
 	steps = skipPrecheckSteps(steps, options)
+	checkers := []string{}
+	selected := selectChecks(options)
+	for _, name := range checker.GetCheckerNames() {
+		if selected[name] {
+			checkers = append(checkers, name)
+		}
+	}
 
 	// add playbook step
 	pb := playbook.NewPlaybook(dingocli)
@@ -176,20 +229,30 @@ func genPrecheckPlaybook(dingocli *cli.DingoCli,
 		case playbook.CHECK_KERNEL_VERSION:
 			// TODO:
 			configs = dingocli.FilterDeployConfigByRole(dcs, ROLE_ALT)
+		case playbook.GET_KERNEL_RELEASE:
+			if len(options.report) == 0 {
+				continue
+			}
 		case playbook.CHECK_HOST_DATE:
 			configs = configs[:1]
+		case playbook.CHECK_SYSTEM:
+			if len(checkers) == 0 {
+				continue
+			}
 		}
 
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: configs,
 			Options: map[string]interface{}{
-				comm.KEY_ALL_DEPLOY_CONFIGS: dcs,
-				comm.KEY_CHECK_WITH_WEAK:    false,
-				comm.KEY_SKIP_CHECKS_ROLES:  skipRoles,
+				comm.KEY_ALL_DEPLOY_CONFIGS:      dcs,
+				comm.KEY_CHECK_WITH_WEAK:         false,
+				comm.KEY_SKIP_CHECKS_ROLES:       skipRoles,
+				comm.KEY_CHECK_SELECTED_CHECKERS: checkers,
 			},
 			ExecOptions: playbook.ExecOptions{
 				SilentSubBar: step == playbook.CHECK_HOST_DATE,
+				Timeout:      options.timeout,
 			},
 		})
 	}
@@ -209,6 +272,26 @@ func genPrecheckPlaybook(dingocli *cli.DingoCli,
 	return pb, nil
 }
 
+// displayCheckResults displays the results of checkers and returns the number of warnings
+func displayCheckResults(dingocli *cli.DingoCli) int {
+	v := dingocli.MemStorage().Get(comm.KEY_ALL_CHECK_RESULTS)
+	if v == nil {
+		return 0
+	}
+
+	results := v.([]checker.CheckResult)
+	checker.SortCheckResults(results)
+	warned := 0
+	for _, r := range results {
+		if r.Status == checker.CHECK_STATUS_WARN {
+			warned++
+		}
+	}
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatCheckResults(results))
+	return warned
+}
+
 func runPrecheck(dingocli *cli.DingoCli, options precheckOptions) error {
 	// 1) parse cluster topology
 	dcs, err := dingocli.ParseTopology()
@@ -224,12 +307,24 @@ func runPrecheck(dingocli *cli.DingoCli, options precheckOptions) error {
 
 	// 3) run playground
 	err = pb.Run()
+	warned := displayCheckResults(dingocli)
+	if len(options.report) > 0 {
+		if err := writePrecheckReport(dingocli, pb, options, err); err != nil {
+			return err
+		}
+		dingocli.WriteOutln("")
+		dingocli.WriteOutln("Precheck report saved to %s", color.GreenString(options.report))
+	}
 	if err != nil {
 		return err
 	}
 
 	// 4) print success prompt
 	dingocli.WriteOutln("")
+	if warned > 0 {
+		dingocli.WriteOutln(color.YellowString("All precheck passed with %d warnings", warned))
+		return nil
+	}
 	dingocli.WriteOutln(color.GreenString("Congratulations!!! all precheck passed :)"))
 	return nil
 }
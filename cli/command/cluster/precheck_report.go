@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/task/task/checker"
+	utils "github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	REPORT_FORMAT_JSON = ".json"
+	REPORT_FORMAT_HTML = ".html"
+
+	REPORT_STATUS_CANCELED = "canceled"
+	REPORT_HOST_LOCAL      = "-"
+
+	PRECHECK_REPORT_HTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Precheck Report: {{.Cluster}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+.pass { color: #2e7d32; } .warn { color: #ef6c00; } .fail, .canceled { color: #c62828; }
+</style>
+</head>
+<body>
+<h1>Precheck Report: {{.Cluster}}</h1>
+<p>Created at {{.CreatedAt}}, result: <b class="{{if .Passed}}pass{{else}}fail{{end}}">{{if .Passed}}PASSED{{else}}FAILED{{end}}</b></p>
+<p>total={{.Summary.Total}} pass={{.Summary.Passed}} warn={{.Summary.Warned}} fail={{.Summary.Failed}} canceled={{.Summary.Canceled}}</p>
+{{if .Skipped}}<p>Skipped checks: {{join .Skipped ", "}}</p>{{end}}
+{{if .NotRun}}<p>Not run checks: {{join .NotRun ", "}}</p>{{end}}
+{{range $host := .HostList}}
+<h2>{{$host}}</h2>
+<table>
+<tr><th>Check</th><th>Role</th><th>Task</th><th>Severity</th><th>Status</th><th>Value</th><th>Error Code</th><th>Hint</th><th>Detail</th></tr>
+{{range index $.ByHost $host}}<tr>
+<td>{{.Check}}</td><td>{{.Role}}</td><td>{{.Task}}</td><td>{{.Severity}}</td>
+<td class="{{.Status}}">{{.Status}}</td><td>{{.Value}}</td>
+<td>{{if .Code}}{{.Code}}{{end}}</td><td>{{.Hint}}</td><td><pre>{{.Detail}}</pre></td>
+</tr>{{end}}
+</table>
+{{end}}
+</body>
+</html>
+`
+)
+
+var (
+	REGEX_SUBNAME_ROLE = regexp.MustCompile(`role=(\S+)`)
+)
+
+type (
+	precheckReportItem struct {
+		Host     string `json:"host"`
+		Role     string `json:"role,omitempty"`
+		Check    string `json:"check"`
+		Task     string `json:"task,omitempty"`
+		Severity string `json:"severity"`
+		Status   string `json:"status"`
+		Value    string `json:"value,omitempty"`
+		Code     int    `json:"code,omitempty"`
+		Hint     string `json:"hint,omitempty"`   // the description of error code
+		Detail   string `json:"detail,omitempty"` // the clue of error code
+	}
+
+	precheckReportSummary struct {
+		Total    int `json:"total"`
+		Passed   int `json:"passed"`
+		Warned   int `json:"warned"`
+		Failed   int `json:"failed"`
+		Canceled int `json:"canceled"`
+	}
+
+	precheckReport struct {
+		Cluster   string                          `json:"cluster"`
+		CreatedAt string                          `json:"created_at"`
+		Passed    bool                            `json:"passed"`
+		Summary   precheckReportSummary           `json:"summary"`
+		Skipped   []string                        `json:"skipped,omitempty"`
+		NotRun    []string                        `json:"not_run,omitempty"`
+		Items     []precheckReportItem            `json:"items"`
+		HostList  []string                        `json:"-"`
+		ByHost    map[string][]precheckReportItem `json:"-"`
+	}
+)
+
+func checkReportFormat(report string) error {
+	if len(report) == 0 {
+		return nil
+	}
+	ext := strings.ToLower(filepath.Ext(report))
+	if ext != REPORT_FORMAT_JSON && ext != REPORT_FORMAT_HTML {
+		return errno.ERR_UNSUPPORT_REPORT_FORMAT.F("report: %s", report)
+	}
+	return nil
+}
+
+func setReportError(item *precheckReportItem, err error) {
+	if ec, ok := err.(*errno.ErrorCode); ok {
+		item.Code = ec.GetCode()
+		item.Hint = ec.GetDescription()
+		item.Detail = ec.GetClue()
+	} else {
+		item.Detail = err.Error()
+	}
+}
+
+// the results of builtin check items come from tasks of playbook
+func newBuiltinReportItems(dingocli *cli.DingoCli, results []playbook.StepResult) []precheckReportItem {
+	items := []precheckReportItem{}
+	skews := checker.GetHostDateSkews(dingocli)
+	releases := checker.GetHostKernelReleases(dingocli)
+	for _, r := range results {
+		check, ok := BELONG_CHECK_ITEM[r.Type]
+		if !ok || r.Err == task.ERR_SKIP_TASK {
+			continue
+		}
+
+		item := precheckReportItem{
+			Host:     utils.Choose(len(r.Host) > 0, r.Host, REPORT_HOST_LOCAL),
+			Check:    check,
+			Task:     r.Name,
+			Severity: checker.CHECK_SEVERITY_FAIL,
+			Status:   checker.CHECK_STATUS_PASS,
+		}
+		if mu := REGEX_SUBNAME_ROLE.FindStringSubmatch(r.Subname); len(mu) > 0 {
+			item.Role = mu[1]
+		}
+		if skew, ok := skews[r.Host]; ok && r.Type == playbook.GET_HOST_DATE {
+			item.Value = fmt.Sprintf("ahead_of_earliest_host=%ds", skew)
+		}
+		if release, ok := releases[r.Host]; ok && r.Type == playbook.GET_KERNEL_RELEASE {
+			item.Value = release
+		}
+
+		if r.Err == task.ERR_TASK_CANCELED {
+			item.Status = REPORT_STATUS_CANCELED
+		} else if r.Err != nil {
+			item.Status = checker.CHECK_STATUS_FAIL
+			setReportError(&item, r.Err)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func newCheckerReportItems(dingocli *cli.DingoCli) []precheckReportItem {
+	items := []precheckReportItem{}
+	v := dingocli.MemStorage().Get(comm.KEY_ALL_CHECK_RESULTS)
+	if v == nil {
+		return items
+	}
+
+	for _, r := range v.([]checker.CheckResult) {
+		item := precheckReportItem{
+			Host:     r.Host,
+			Role:     r.Role,
+			Check:    r.Check,
+			Severity: r.Severity,
+			Status:   r.Status,
+			Value:    r.Value,
+			Code:     r.Code,
+			Hint:     r.Error,
+			Detail:   r.Clue,
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func genPrecheckReport(dingocli *cli.DingoCli, pb *playbook.Playbook, options precheckOptions, err error) *precheckReport {
+	report := &precheckReport{
+		Cluster:   dingocli.ClusterName(),
+		CreatedAt: time.Now().Format(time.RFC3339),
+		Passed:    err == nil,
+		Items:     newBuiltinReportItems(dingocli, pb.Results()),
+		ByHost:    map[string][]precheckReportItem{},
+	}
+	report.Items = append(report.Items, newCheckerReportItems(dingocli)...)
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		if report.Items[i].Host != report.Items[j].Host {
+			return report.Items[i].Host < report.Items[j].Host
+		}
+		return report.Items[i].Check < report.Items[j].Check
+	})
+
+	// summary and group by host
+	done := map[string]bool{}
+	for _, item := range report.Items {
+		report.Summary.Total++
+		switch item.Status {
+		case checker.CHECK_STATUS_PASS:
+			report.Summary.Passed++
+		case checker.CHECK_STATUS_WARN:
+			report.Summary.Warned++
+		case checker.CHECK_STATUS_FAIL:
+			report.Summary.Failed++
+		case REPORT_STATUS_CANCELED:
+			report.Summary.Canceled++
+		}
+		if _, ok := report.ByHost[item.Host]; !ok {
+			report.HostList = append(report.HostList, item.Host)
+		}
+		report.ByHost[item.Host] = append(report.ByHost[item.Host], item)
+		done[item.Check] = true
+	}
+
+	// checks which deselected or not executed for precheck interrupted
+	selected := selectChecks(options)
+	for _, name := range getCheckNames() {
+		if !selected[name] {
+			report.Skipped = append(report.Skipped, name)
+		} else if !done[name] {
+			report.NotRun = append(report.NotRun, name)
+		}
+	}
+	return report
+}
+
+func renderPrecheckReport(report *precheckReport, format string) (string, error) {
+	if format == REPORT_FORMAT_JSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		return string(data), err
+	}
+
+	tmpl, err := template.New("report").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Parse(PRECHECK_REPORT_HTML)
+	if err != nil {
+		return "", err
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, report); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+func writePrecheckReport(dingocli *cli.DingoCli, pb *playbook.Playbook, options precheckOptions, err error) error {
+	report := genPrecheckReport(dingocli, pb, options, err)
+	content, err := renderPrecheckReport(report, strings.ToLower(filepath.Ext(options.report)))
+	if err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	} else if err := utils.WriteFile(options.report, content, 0644); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/task/task/checker"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+/*
+ * TestSelectChecks, run: go test ./cli/command/cluster -run ^TestSelectChecks$
+ */
+func TestSelectChecks(t *testing.T) {
+	without := func(names []string, excludes ...string) []string {
+		out := []string{}
+		for _, name := range names {
+			if !utils.Contains(excludes, name) {
+				out = append(out, name)
+			}
+		}
+		return out
+	}
+	all := getCheckNames()
+
+	tests := []struct {
+		name    string
+		options precheckOptions
+		expect  []string
+	}{
+		{"all", precheckOptions{}, all},
+		{"skip item", precheckOptions{skip: []string{CHECK_ITEM_TOPOLOGY}}, without(all, CHECK_ITEM_TOPOLOGY)},
+		{
+			"skip checks",
+			precheckOptions{skipChecks: []string{checker.CHECKER_NTP, CHECK_ITEM_DATE}},
+			without(all, checker.CHECKER_NTP, CHECK_ITEM_DATE),
+		},
+		{
+			"only checks",
+			precheckOptions{onlyChecks: []string{checker.CHECKER_MEMORY, checker.CHECKER_FD}},
+			[]string{checker.CHECKER_MEMORY, checker.CHECKER_FD},
+		},
+		{
+			"only and skip checks",
+			precheckOptions{
+				onlyChecks: []string{checker.CHECKER_MEMORY, checker.CHECKER_FD},
+				skipChecks: []string{checker.CHECKER_FD},
+			},
+			[]string{checker.CHECKER_MEMORY},
+		},
+		{"builtin items only", precheckOptions{onlyChecks: CHECK_ITEMS}, CHECK_ITEMS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := selectChecks(tt.options)
+			if !reflect.DeepEqual(selected, utils.Slice2Map(tt.expect)) {
+				t.Errorf("expect %v, got %v", tt.expect, selected)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure/topology"
@@ -35,10 +36,11 @@ var (
 )
 
 type startOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id      string
+	role    string
+	host    string
+	force   bool
+	timeout time.Duration
 }
 
 func checkCommonOptions(dingocli *cli.DingoCli, id, role, host string) error {
@@ -84,6 +86,7 @@ func NewStartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -106,6 +109,9 @@ func genStartPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: dcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
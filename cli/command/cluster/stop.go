@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure/topology"
@@ -35,10 +36,11 @@ var (
 )
 
 type stopOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id      string
+	role    string
+	host    string
+	force   bool
+	timeout time.Duration
 }
 
 func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -62,6 +64,7 @@ func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -84,6 +87,9 @@ func genStopPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: dcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
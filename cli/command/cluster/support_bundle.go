@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SUPPORT_BUNDLE_EXAMPLE = `Examples:
+  $ dingo cluster support-bundle                        # Collect artifacts of all services into tarball
+  $ dingo cluster support-bundle --role mds             # Collect artifacts of mds services
+  $ dingo cluster support-bundle --since 2h -o /tmp/a.tar.gz  # Collect logs in the last 2 hours into /tmp/a.tar.gz`
+
+	DEFAULT_SUPPORT_BUNDLE_LOG_TAIL = 5000
+
+	SUPPORT_BUNDLE_MANIFEST = "manifest.json"
+	SUPPORT_BUNDLE_TOPOLOGY = "topology.yaml"
+	SUPPORT_BUNDLE_HOSTS    = "hosts.yaml"
+)
+
+var (
+	SUPPORT_BUNDLE_PLAYBOOK_STEPS = []int{
+		playbook.COLLECT_HOST_INFO,
+		playbook.COLLECT_SERVICE_INFO,
+	}
+)
+
+type (
+	supportBundleOptions struct {
+		role   string
+		host   string
+		since  string
+		tail   int
+		output string
+	}
+
+	supportBundleManifest struct {
+		Cluster   string            `json:"cluster"`
+		CreatedAt string            `json:"created_at"`
+		Collected int               `json:"collected"`
+		Failed    int               `json:"failed"`
+		Items     []task.BundleItem `json:"items"`
+	}
+)
+
+func NewSupportBundleCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options supportBundleOptions
+
+	cmd := &cobra.Command{
+		Use:     "support-bundle [OPTIONS]",
+		Short:   "Collect logs, configs and system information into a tarball",
+		Args:    cliutil.NoArgs,
+		Example: SUPPORT_BUNDLE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSupportBundle(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVar(&options.since, "since", "", "Only collect container logs since timestamp (e.g. 2026-01-02T15:04:05) or relative (e.g. 2h)")
+	flags.IntVar(&options.tail, "tail", DEFAULT_SUPPORT_BUNDLE_LOG_TAIL, "Number of lines to collect from the end of each log, 0 means all")
+	flags.StringVarP(&options.output, "output", "o", "", "Specify the tarball path (default \"./dingo-support-bundle-<cluster>-<time>.tar.gz\")")
+
+	return cmd
+}
+
+func genSupportBundlePlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	bundle *task.SupportBundle) (*playbook.Playbook, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range SUPPORT_BUNDLE_PLAYBOOK_STEPS {
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: dcs,
+			Options: map[string]interface{}{
+				comm.KEY_SUPPORT_BUNDLE: bundle,
+			},
+			ExecOptions: playbook.ExecOptions{
+				SkipError: true, // collect as much as possible
+			},
+		})
+	}
+	return pb, nil
+}
+
+// services or hosts which we can't connect have no items recorded
+func recordUnreachable(bundle *task.SupportBundle, dcs []*topology.DeployConfig, dingocli *cli.DingoCli) {
+	recorded := map[string]bool{}
+	for _, item := range bundle.Items() {
+		recorded[item.Host+"/"+item.Service] = true
+	}
+
+	for _, dc := range dcs {
+		serviceId := dingocli.GetServiceId(dc.GetId())
+		if !recorded[dc.GetHost()+"/"] {
+			recorded[dc.GetHost()+"/"] = true
+			bundle.Record(task.BundleItem{
+				Host:  dc.GetHost(),
+				Item:  "host",
+				Error: "collect host info failed, see the log for details",
+			})
+		}
+		if !recorded[dc.GetHost()+"/"+serviceId] {
+			bundle.Record(task.BundleItem{
+				Host:    dc.GetHost(),
+				Service: serviceId,
+				Role:    dc.GetRole(),
+				Item:    "service",
+				Error:   "collect service info failed, see the log for details",
+			})
+		}
+	}
+}
+
+func writeSupportBundleFiles(dingocli *cli.DingoCli, bundle *task.SupportBundle, now time.Time) (int, error) {
+	err := bundle.Save(SUPPORT_BUNDLE_TOPOLOGY, task.RedactSecrets(dingocli.ClusterTopologyData()))
+	if err != nil {
+		return 0, errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	err = bundle.Save(SUPPORT_BUNDLE_HOSTS, task.RedactSecrets(dingocli.Hosts()))
+	if err != nil {
+		return 0, errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+
+	manifest := supportBundleManifest{
+		Cluster:   dingocli.ClusterName(),
+		CreatedAt: now.Format(time.RFC3339),
+		Items:     bundle.Items(),
+	}
+	for _, item := range manifest.Items {
+		if len(item.Error) > 0 {
+			manifest.Failed++
+		} else {
+			manifest.Collected++
+		}
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return 0, errno.ERR_WRITE_FILE_FAILED.E(err)
+	} else if err := bundle.Save(SUPPORT_BUNDLE_MANIFEST, string(data)); err != nil {
+		return 0, errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	return manifest.Failed, nil
+}
+
+func runSupportBundle(dingocli *cli.DingoCli, options supportBundleOptions) error {
+	// 1) parse cluster topology and filter services
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return err
+	}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   "*",
+		Role: options.role,
+		Host: options.host,
+	})
+	if len(dcs) == 0 {
+		return errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	// 2) create the staging directory
+	now := time.Now()
+	name := fmt.Sprintf("dingo-support-bundle-%s-%s", dingocli.ClusterName(), now.Format("20060102150405"))
+	output := options.output
+	if len(output) == 0 {
+		output = name + ".tar.gz"
+	}
+	stagingDir, err := os.MkdirTemp(dingocli.TempDir(), "support-bundle-")
+	if err != nil {
+		return errno.ERR_CREATE_DIRECTORY_FAILED.E(err)
+	}
+	defer os.RemoveAll(stagingDir)
+	dir := filepath.Join(stagingDir, name)
+	bundle := task.NewSupportBundle(dir, options.tail, options.since)
+
+	// 3) collect host and service info in parallel
+	pb, err := genSupportBundlePlaybook(dingocli, dcs, bundle)
+	if err != nil {
+		return err
+	} else if err := pb.Run(); err != nil {
+		return err
+	}
+	recordUnreachable(bundle, dcs, dingocli)
+
+	// 4) write topology, hosts, manifest and archive them all
+	failed, err := writeSupportBundleFiles(dingocli, bundle, now)
+	if err != nil {
+		return err
+	} else if err := cliutil.TarGzDirectory(dir, output); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln("Support bundle saved to %s", color.GreenString(output))
+	if failed > 0 {
+		dingocli.WriteOutln(color.YellowString("%d items failed to collect, see %s in the bundle for details",
+			failed, SUPPORT_BUNDLE_MANIFEST))
+	}
+	return nil
+}
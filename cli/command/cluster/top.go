@@ -0,0 +1,342 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/rpc"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/service"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	TOP_EXAMPLE = `Examples:
+  $ dingo cluster top                         # Display live dashboard of all services
+  $ dingo cluster top --role mds              # Only display mds services at startup
+  $ dingo cluster top --interval 10s          # Refresh every 10 seconds
+
+Keys:
+  r        switch role filter
+  h        switch host filter
+  a        clear all filters
+  space    refresh now
+  q        quit`
+
+	ANSI_ENTER_SCREEN = "\033[?1049h\033[?25l"
+	ANSI_LEAVE_SCREEN = "\033[?25h\033[?1049l"
+	ANSI_CLEAR_SCREEN = "\033[H\033[2J"
+
+	TOP_FILTER_ALL = "*"
+	KEY_CTRL_C     = 3
+)
+
+type (
+	topOptions struct {
+		role     string
+		host     string
+		interval time.Duration
+	}
+
+	topSnapshot struct {
+		tops    []task.ServiceTop
+		mdsErr  error
+		updated time.Time
+	}
+
+	topFilter struct {
+		role  string
+		host  string
+		roles []string
+		hosts []string
+	}
+)
+
+func NewTopCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options topOptions
+
+	cmd := &cobra.Command{
+		Use:     "top [OPTIONS]",
+		Short:   "Display live dashboard of cluster services",
+		Args:    utils.NoArgs,
+		Example: TOP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTop(cmd, dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.role, "role", TOP_FILTER_ALL, "Specify service role filter")
+	flags.StringVar(&options.host, "host", TOP_FILTER_ALL, "Specify service host filter")
+	flags.DurationVar(&options.interval, "interval", 5*time.Second, "Specify refresh interval")
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address, default is the mds address of cluster")
+
+	return cmd
+}
+
+// cycleFilter returns the next item of items after current, "*" stands for all
+func cycleFilter(items []string, current string) string {
+	all := append([]string{TOP_FILTER_ALL}, items...)
+	for i, item := range all {
+		if item == current {
+			return all[(i+1)%len(all)]
+		}
+	}
+	return TOP_FILTER_ALL
+}
+
+func (f *topFilter) match(top task.ServiceTop) bool {
+	return (f.role == TOP_FILTER_ALL || f.role == top.Role) &&
+		(f.host == TOP_FILTER_ALL || f.host == top.Host)
+}
+
+func newTopFilter(dcs []*topology.DeployConfig, options topOptions) *topFilter {
+	filter := &topFilter{role: options.role, host: options.host}
+	for _, dc := range dcs {
+		if !utils.Contains(filter.roles, dc.GetRole()) {
+			filter.roles = append(filter.roles, dc.GetRole())
+		}
+		if !utils.Contains(filter.hosts, dc.GetHost()) {
+			filter.hosts = append(filter.hosts, dc.GetHost())
+		}
+	}
+	return filter
+}
+
+func getTopDeployConfigs(dingocli *cli.DingoCli) ([]*topology.DeployConfig, error) {
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return nil, err
+	}
+
+	// skip ROLE_TMP dc
+	out := []*topology.DeployConfig{}
+	for _, dc := range dcs {
+		if dc.GetRole() != topology.ROLE_FS_MDS_CLI {
+			out = append(out, dc)
+		}
+	}
+	if len(out) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+	return out, nil
+}
+
+// fill the online state of mds services which is reported by mds cluster
+func fillMdsOnline(cmd *cobra.Command, dcs []*topology.DeployConfig, tops []task.ServiceTop) error {
+	hasMds := false
+	for _, top := range tops {
+		hasMds = hasMds || top.Role == topology.ROLE_FS_MDS
+	}
+	if !hasMds {
+		return nil
+	}
+
+	if !cmd.Flag(utils.DINGOFS_MDSADDR).Changed {
+		cmd.Flags().Set(utils.DINGOFS_MDSADDR, getClusterMdsAddr(dcs))
+	}
+	mdses, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return err
+	}
+
+	online := map[string]string{}
+	for _, mds := range mdses {
+		addr := fmt.Sprintf("%s:%d", mds.GetLocation().GetHost(), mds.GetLocation().GetPort())
+		online[addr] = utils.Choose(mds.GetIsOnline(), comm.ROW_VALUE_ONLINE, comm.ROW_VALUE_OFFLINE)
+	}
+	for i, top := range tops {
+		if top.Role != topology.ROLE_FS_MDS {
+			continue
+		}
+		dc := top.Config
+		if state, ok := online[fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetListenPort())]; ok {
+			tops[i].Online = state
+		}
+	}
+	return nil
+}
+
+func collectServiceTop(ctx context.Context, cmd *cobra.Command, dingocli *cli.DingoCli, dcs []*topology.DeployConfig) topSnapshot {
+	dingocli.MemStorage().Set(comm.KEY_ALL_SERVICE_TOP, map[string]task.ServiceTop{})
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.GET_SERVICE_TOP,
+		Configs: dcs,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar:  true,
+			SilentMainBar: true,
+			SkipError:     true,
+		},
+	})
+	pb.RunContext(ctx)
+
+	snapshot := topSnapshot{updated: time.Now()}
+	value := dingocli.MemStorage().Get(comm.KEY_ALL_SERVICE_TOP)
+	if value != nil {
+		for _, top := range value.(map[string]task.ServiceTop) {
+			snapshot.tops = append(snapshot.tops, top)
+		}
+	}
+	snapshot.mdsErr = fillMdsOnline(cmd, dcs, snapshot.tops)
+	return snapshot
+}
+
+func renderTop(dingocli *cli.DingoCli, snapshot *topSnapshot, filter *topFilter, options topOptions) string {
+	lines := []string{
+		fmt.Sprintf("cluster name : %s", dingocli.ClusterName()),
+		fmt.Sprintf("filter       : role=%s host=%s", filter.role, filter.host),
+	}
+	if snapshot == nil {
+		lines = append(lines, "", "Collecting service status...")
+		return strings.Join(lines, "\n")
+	}
+
+	lines = append(lines, fmt.Sprintf("updated at   : %s (every %s)",
+		snapshot.updated.Format("2006-01-02 15:04:05"), options.interval))
+	if snapshot.mdsErr != nil {
+		lines = append(lines, color.RedString("mds online   : %s", snapshot.mdsErr))
+	}
+
+	tops := []task.ServiceTop{}
+	for _, top := range snapshot.tops {
+		if filter.match(top) {
+			tops = append(tops, top)
+		}
+	}
+	lines = append(lines, "", tui.FormatServiceTop(tops))
+	lines = append(lines, "[r] role  [h] host  [a] all  [space] refresh  [q] quit")
+	return strings.Join(lines, "\n")
+}
+
+// readKeys reads the pressed keys from the terminal
+func readKeys(keys chan<- byte) {
+	buffer := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(buffer)
+		if err != nil {
+			close(keys)
+			return
+		} else if n > 0 {
+			keys <- buffer[0]
+		}
+	}
+}
+
+func runTop(cmd *cobra.Command, dingocli *cli.DingoCli, options topOptions) error {
+	if options.interval <= 0 {
+		return errno.ERR_INVALID_REFRESH_INTERVAL.F("interval: %s", options.interval)
+	}
+
+	// 1) parse cluster topology
+	dcs, err := getTopDeployConfigs(dingocli)
+	if err != nil {
+		return err
+	}
+	filter := newTopFilter(dcs, options)
+
+	// SIGINT/SIGTERM stops the dashboard and the running collection,
+	// it's the only way to quit if stdin is not a terminal
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 2) enter full-screen mode, the keyboard is only available in terminal
+	keys := make(chan byte)
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		defer term.Restore(fd, state)
+		go readKeys(keys)
+	}
+	dingocli.WriteOut(ANSI_ENTER_SCREEN)
+	defer dingocli.WriteOut(ANSI_LEAVE_SCREEN)
+
+	// 3) collect service status in background and refresh the screen
+	var snapshot *topSnapshot
+	snapshots := make(chan topSnapshot, 1)
+	refresh := make(chan struct{}, 1)
+	defer close(refresh)
+	go func() {
+		for range refresh {
+			snapshots <- collectServiceTop(ctx, cmd, dingocli, dcs)
+		}
+	}()
+	refresh <- struct{}{}
+
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+	for {
+		// raw mode doesn't translate "\n" to "\r\n"
+		output := renderTop(dingocli, snapshot, filter, options)
+		dingocli.WriteOut("%s%s", ANSI_CLEAR_SCREEN, strings.ReplaceAll(output, "\n", "\r\n"))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case s := <-snapshots:
+			snapshot = &s
+		case <-ticker.C:
+			select {
+			case refresh <- struct{}{}:
+			default: // last collection is still running
+			}
+		case key, ok := <-keys:
+			if !ok {
+				keys = nil
+				continue
+			}
+			switch key {
+			case 'q', 'Q', KEY_CTRL_C:
+				return nil
+			case 'r':
+				filter.role = cycleFilter(filter.roles, filter.role)
+			case 'h':
+				filter.host = cycleFilter(filter.hosts, filter.host)
+			case 'a':
+				filter.role, filter.host = TOP_FILTER_ALL, TOP_FILTER_ALL
+			case ' ':
+				select {
+				case refresh <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
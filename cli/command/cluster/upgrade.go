@@ -17,6 +17,8 @@
 package cluster
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure/topology"
@@ -60,6 +62,7 @@ type upgradeOptions struct {
 	host          string
 	force         bool
 	useLocalImage bool
+	timeout       time.Duration
 }
 
 func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -84,6 +87,7 @@ func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -142,6 +146,9 @@ func genUpgradePlaybook(dingocli *cli.DingoCli,
 				comm.KEY_SKIP_MDSV2_CLI:   true,
 				comm.KEY_UPGRADE_FLAG:     true,
 			},
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
@@ -24,6 +24,7 @@ import (
 	"github.com/dingodb/dingocli/cli/command/cluster"
 	"github.com/dingodb/dingocli/cli/command/component"
 	"github.com/dingodb/dingocli/cli/command/config"
+	"github.com/dingodb/dingocli/cli/command/exporter"
 	"github.com/dingodb/dingocli/cli/command/fs"
 	"github.com/dingodb/dingocli/cli/command/hosts"
 	"github.com/dingodb/dingocli/cli/command/mds"
@@ -78,6 +79,7 @@ func addSubCommands(cmd *cobra.Command, dingocli *cli.DingoCli) {
 		mds.NewMDSCommand(dingocli),             // dingocli mds ...
 		fs.NewFSCommand(dingocli),               // dingocli fs ...
 		component.NewComponentCommand(dingocli), // dingocli component ...
+		exporter.NewExporterCommand(dingocli),   // dingocli exporter
 
 		NewAuditCommand(dingocli),      // dingocli audit
 		NewCompletionCommand(dingocli), // dingocli completion
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporter
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+)
+
+const (
+	EXPORTER_EXAMPLE = `Examples:
+   $ dingo exporter                                  # Expose filesystem metrics on :9567/metrics
+   $ dingo exporter --listen :9000 --interval 1m     # Specify listen address and poll interval`
+
+	DEFAULT_LISTEN_ADDRESS = ":9567"
+	DEFAULT_POLL_INTERVAL  = 30 * time.Second
+	METRICS_PATH           = "/metrics"
+)
+
+type exporterOptions struct {
+	listen   string
+	interval time.Duration
+}
+
+func NewExporterCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options exporterOptions
+
+	cmd := &cobra.Command{
+		Use:     "exporter [OPTIONS]",
+		Short:   "Run prometheus exporter for filesystem capacity, quota and mds metrics",
+		GroupID: "UTILS",
+		Args:    utils.NoArgs,
+		Example: EXPORTER_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runExporter(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringVar(&options.listen, "listen", DEFAULT_LISTEN_ADDRESS, "Specify address to expose metrics")
+	cmd.Flags().DurationVar(&options.interval, "interval", DEFAULT_POLL_INTERVAL, "Specify interval to poll mds cluster")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runExporter(cmd *cobra.Command, dingocli *cli.DingoCli, options exporterOptions) error {
+	if options.interval <= 0 {
+		return errno.ERR_INVALID_REFRESH_INTERVAL.F("interval: %s", options.interval)
+	}
+
+	// 1) listen first, so the scrape doesn't wait for the first poll
+	listener, err := net.Listen("tcp", options.listen)
+	if err != nil {
+		return errno.ERR_START_FS_EXPORTER_FAILED.E(err)
+	}
+
+	// 2) poll mds cluster in background
+	collector := newFsCollector()
+	go func() {
+		collector.poll(cmd)
+		ticker := time.NewTicker(options.interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			collector.poll(cmd)
+		}
+	}()
+
+	// 3) expose metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+	mux := http.NewServeMux()
+	mux.Handle(METRICS_PATH, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, METRICS_PATH, http.StatusFound)
+	})
+
+	dingocli.WriteOutln("Exporter is listening on %s%s", options.listen, METRICS_PATH)
+	if err := http.Serve(listener, mux); err != nil {
+		return errno.ERR_START_FS_EXPORTER_FAILED.E(err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/rpc"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	METRIC_NAMESPACE = "dingofs"
+)
+
+var (
+	descFsCapacity = newDesc("fs_capacity_bytes", "Capacity of filesystem in bytes, 0 means unlimited.", "fs")
+	descFsUsed     = newDesc("fs_used_bytes", "Used bytes of filesystem.", "fs")
+	descFsInodes   = newDesc("fs_inodes", "Used inodes of filesystem.", "fs")
+	descFsMaxInode = newDesc("fs_max_inodes", "Max inodes of filesystem, 0 means unlimited.", "fs")
+	descFsReadBps  = newDesc("fs_read_bytes", "Read bytes of filesystem reported by mds.", "fs")
+	descFsReadQps  = newDesc("fs_read_qps", "Read qps of filesystem reported by mds.", "fs")
+	descFsWriteBps = newDesc("fs_write_bytes", "Write bytes of filesystem reported by mds.", "fs")
+	descFsWriteQps = newDesc("fs_write_qps", "Write qps of filesystem reported by mds.", "fs")
+
+	descQuotaMaxBytes   = newDesc("dir_quota_max_bytes", "Max bytes of directory quota, 0 means unlimited.", "fs", "path")
+	descQuotaUsedBytes  = newDesc("dir_quota_used_bytes", "Used bytes of directory quota.", "fs", "path")
+	descQuotaMaxInodes  = newDesc("dir_quota_max_inodes", "Max inodes of directory quota, 0 means unlimited.", "fs", "path")
+	descQuotaUsedInodes = newDesc("dir_quota_used_inodes", "Used inodes of directory quota.", "fs", "path")
+
+	descMdsOnline = newDesc("mds_online", "Whether the mds is online (1) or not (0).", "mds", "id")
+
+	descScrapeSuccess  = newDesc("exporter_last_poll_success", "Whether the last poll of mds cluster succeeded.")
+	descScrapeTime     = newDesc("exporter_last_poll_timestamp_seconds", "Timestamp of the last poll of mds cluster.")
+	descScrapeDuration = newDesc("exporter_last_poll_duration_seconds", "Duration of the last poll of mds cluster.")
+)
+
+func newDesc(name, help string, labels ...string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(METRIC_NAMESPACE, "", name), help, labels, nil)
+}
+
+type (
+	sample struct {
+		desc   *prometheus.Desc
+		value  float64
+		labels []string
+	}
+
+	// fsCollector polls the mds cluster on interval and exposes the latest samples,
+	// so the scrape of prometheus never blocks on rpc.
+	fsCollector struct {
+		mutex    sync.RWMutex
+		samples  []sample
+		success  bool
+		pollTime time.Time
+		duration time.Duration
+	}
+)
+
+var _ prometheus.Collector = (*fsCollector)(nil) // check interface
+
+func newFsCollector() *fsCollector {
+	return &fsCollector{}
+}
+
+func (c *fsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		descFsCapacity, descFsUsed, descFsInodes, descFsMaxInode,
+		descFsReadBps, descFsReadQps, descFsWriteBps, descFsWriteQps,
+		descQuotaMaxBytes, descQuotaUsedBytes, descQuotaMaxInodes, descQuotaUsedInodes,
+		descMdsOnline,
+		descScrapeSuccess, descScrapeTime, descScrapeDuration,
+	} {
+		ch <- desc
+	}
+}
+
+func (c *fsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for _, s := range c.samples {
+		ch <- prometheus.MustNewConstMetric(s.desc, prometheus.GaugeValue, s.value, s.labels...)
+	}
+	success := 0.0
+	if c.success {
+		success = 1
+	}
+	ch <- prometheus.MustNewConstMetric(descScrapeSuccess, prometheus.GaugeValue, success)
+	if !c.pollTime.IsZero() {
+		ch <- prometheus.MustNewConstMetric(descScrapeTime, prometheus.GaugeValue, float64(c.pollTime.Unix()))
+		ch <- prometheus.MustNewConstMetric(descScrapeDuration, prometheus.GaugeValue, c.duration.Seconds())
+	}
+}
+
+func pollMds(cmd *cobra.Command) ([]sample, error) {
+	mdses, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := []sample{}
+	for _, mdsInfo := range mdses {
+		online := 0.0
+		if mdsInfo.GetIsOnline() {
+			online = 1
+		}
+		addr := fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort())
+		samples = append(samples, sample{descMdsOnline, online, []string{addr, fmt.Sprintf("%d", mdsInfo.GetId())}})
+	}
+	return samples, nil
+}
+
+func pollFs(cmd *cobra.Command, fsInfo *mds.FsInfo) ([]sample, error) {
+	fsId, fsName := fsInfo.GetFsId(), fsInfo.GetFsName()
+	epoch := rpc.GetFsEpochByFsInfo(fsInfo)
+	samples := []sample{}
+
+	// capacity and usage
+	quota, err := rpc.GetFsQuota(cmd, fsId, epoch)
+	if err != nil {
+		return nil, err
+	}
+	fsQuota := quota.GetQuota()
+	samples = append(samples,
+		sample{descFsCapacity, float64(fsQuota.GetMaxBytes()), []string{fsName}},
+		sample{descFsUsed, float64(fsQuota.GetUsedBytes()), []string{fsName}},
+		sample{descFsInodes, float64(fsQuota.GetUsedInodes()), []string{fsName}},
+		sample{descFsMaxInode, float64(fsQuota.GetMaxInodes()), []string{fsName}},
+	)
+
+	// io stats
+	stats, err := rpc.GetFsStats(cmd, fsName, epoch)
+	if err != nil {
+		return nil, err
+	}
+	fsStats := stats.GetStats()
+	samples = append(samples,
+		sample{descFsReadBps, float64(fsStats.GetReadBytes()), []string{fsName}},
+		sample{descFsReadQps, float64(fsStats.GetReadQps()), []string{fsName}},
+		sample{descFsWriteBps, float64(fsStats.GetWriteBytes()), []string{fsName}},
+		sample{descFsWriteQps, float64(fsStats.GetWriteQps()), []string{fsName}},
+	)
+
+	// directory quotas
+	if err := rpc.InitFsMDSRouter(cmd, fsId); err != nil {
+		return nil, err
+	}
+	dirQuotas, err := rpc.LoadDirQuotas(cmd, fsId, epoch)
+	if err != nil {
+		return nil, err
+	}
+	for dirInode, quota := range dirQuotas.GetQuotas() {
+		path, _, err := rpc.GetInodePath(cmd, fsId, dirInode, epoch)
+		if errors.Is(err, syscall.ENOENT) || (err == nil && len(path) == 0) { // directory may be deleted
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		labels := []string{fsName, path}
+		samples = append(samples,
+			sample{descQuotaMaxBytes, float64(quota.GetMaxBytes()), labels},
+			sample{descQuotaUsedBytes, float64(quota.GetUsedBytes()), labels},
+			sample{descQuotaMaxInodes, float64(quota.GetMaxInodes()), labels},
+			sample{descQuotaUsedInodes, float64(quota.GetUsedInodes()), labels},
+		)
+	}
+	return samples, nil
+}
+
+// poll collects all metrics from mds cluster, the samples of filesystem which poll
+// failed are dropped and the last poll is marked as failed.
+func (c *fsCollector) poll(cmd *cobra.Command) {
+	start := time.Now()
+	success := true
+	samples, err := pollMds(cmd)
+	if err != nil {
+		success = false
+		log.Error("Poll mds list failed", log.Field("Error", err))
+	}
+
+	fsInfos, err := rpc.ListFsInfo(cmd)
+	if err != nil {
+		success = false
+		log.Error("Poll filesystem list failed", log.Field("Error", err))
+	}
+	for _, fsInfo := range fsInfos {
+		if fsInfo.GetStatus() != mds.FsStatus_NORMAL {
+			continue
+		}
+		fsSamples, err := pollFs(cmd, fsInfo)
+		if err != nil {
+			success = false
+			log.Error("Poll filesystem metrics failed",
+				log.Field("FsName", fsInfo.GetFsName()),
+				log.Field("Error", err))
+			continue
+		}
+		samples = append(samples, fsSamples...)
+	}
+
+	c.update(samples, success, start)
+}
+
+func (c *fsCollector) update(samples []sample, success bool, start time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.samples = samples
+	c.success = success
+	c.pollTime = start
+	c.duration = time.Since(start)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package exporter
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gatherGauges returns the gauge values keyed by name{label=value,...}
+func gatherGauges(t *testing.T, collector prometheus.Collector) map[string]float64 {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics failed: %v", err)
+	}
+
+	gauges := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := []string{}
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, fmt.Sprintf("%s=%s", label.GetName(), label.GetValue()))
+			}
+			sort.Strings(labels)
+			key := family.GetName()
+			if len(labels) > 0 {
+				key += "{" + strings.Join(labels, ",") + "}"
+			}
+			gauges[key] = metric.GetGauge().GetValue()
+		}
+	}
+	return gauges
+}
+
+/*
+ * TestFsCollector, run: go test ./cli/command/exporter -run ^TestFsCollector$
+ */
+func TestFsCollector(t *testing.T) {
+	descDurationName := "dingofs_exporter_last_poll_duration_seconds"
+	start := time.Unix(1700000000, 0)
+	tests := []struct {
+		name    string
+		samples []sample
+		success bool
+		polled  bool
+		expect  map[string]float64
+	}{
+		{
+			name:   "not polled yet",
+			expect: map[string]float64{"dingofs_exporter_last_poll_success": 0},
+		},
+		{
+			name: "poll succeeded",
+			samples: []sample{
+				{descMdsOnline, 1, []string{"10.0.0.1:7400", "1"}},
+				{descFsCapacity, 1024, []string{"fs1"}},
+				{descFsUsed, 512, []string{"fs1"}},
+				{descQuotaUsedInodes, 3, []string{"fs1", "/a"}},
+			},
+			success: true,
+			polled:  true,
+			expect: map[string]float64{
+				"dingofs_mds_online{id=1,mds=10.0.0.1:7400}":    1,
+				"dingofs_fs_capacity_bytes{fs=fs1}":             1024,
+				"dingofs_fs_used_bytes{fs=fs1}":                 512,
+				"dingofs_dir_quota_used_inodes{fs=fs1,path=/a}": 3,
+				"dingofs_exporter_last_poll_success":            1,
+				"dingofs_exporter_last_poll_timestamp_seconds":  1700000000,
+			},
+		},
+		{
+			name: "poll failed",
+			samples: []sample{
+				{descMdsOnline, 0, []string{"10.0.0.1:7400", "1"}},
+			},
+			success: false,
+			polled:  true,
+			expect: map[string]float64{
+				"dingofs_mds_online{id=1,mds=10.0.0.1:7400}":   0,
+				"dingofs_exporter_last_poll_success":           0,
+				"dingofs_exporter_last_poll_timestamp_seconds": 1700000000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := newFsCollector()
+			if tt.polled {
+				collector.update(tt.samples, tt.success, start)
+			}
+			gauges := gatherGauges(t, collector)
+			if _, ok := gauges[descDurationName]; ok != tt.polled {
+				t.Errorf("expect %s exists=%v, got %v", descDurationName, tt.polled, ok)
+			}
+			delete(gauges, descDurationName) // the duration is not stable
+			if !reflect.DeepEqual(gauges, tt.expect) {
+				t.Errorf("expect gauges %v, got %v", tt.expect, gauges)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ func NewStatsCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	cmd.AddCommand(
 		NewStatsMountpointCommand(dingocli),
+		NewStatsReplayCommand(dingocli),
 	)
 
 	return cmd
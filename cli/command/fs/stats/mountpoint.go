@@ -23,7 +23,6 @@ import (
 	"math"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
@@ -37,7 +36,10 @@ import (
 
 const (
 	STATS_MOUNTPOINT_EXAMPLE = `Examples:
-   $ dingo stats mountpoint /mnt/dingofs`
+   $ dingo fs stats mountpoint /mnt/dingofs
+   $ dingo fs stats mountpoint /mnt/dingofs host1:/mnt/dingofs host2:/mnt/dingofs
+   $ dingo fs stats mountpoint /mnt/dingofs --record stats.jsonl
+   $ dingo fs stats mountpoint /mnt/dingofs --format csv > stats.csv`
 )
 
 // colors
@@ -64,14 +66,14 @@ const (
 
 // metirc types
 const (
-	metricByte = 1 << iota
-	metricCount
-	metricTime
-	metricCPU
-	metricGauge
-	metricCounter
-	metricHist
-	metricHit
+	MetricByte = 1 << iota
+	MetricCount
+	MetricTime
+	MetricCPU
+	MetricGauge
+	MetricCounter
+	MetricHist
+	MetricHit
 )
 
 const MaxItemSize = 5
@@ -96,14 +98,20 @@ type statsWatcher struct {
 	sections   []*section
 	cpuUsage   float64
 	count      uint32
+	format     string
+	timestamp  bool // show the time of sample at the beginning of row
+	csvHeader  bool
+	labelWidth int // width of target column, 0 means single target
 }
 
 type mountpointOptions struct {
-	mountpoint string
-	schema     string
-	interval   time.Duration
-	count      uint32
-	verbose    bool
+	mountpoints []string
+	schema      string
+	interval    time.Duration
+	count       uint32
+	verbose     bool
+	record      string
+	format      string
 }
 
 // set logout to stdout
@@ -115,14 +123,14 @@ func NewStatsMountpointCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options mountpointOptions
 
 	cmd := &cobra.Command{
-		Use:     "mountpoint MOUNTPOINT [OPTIONS]",
-		Short:   "show real time performance statistics of mountpoint",
-		Args:    utils.ExactArgs(1),
+		Use:     "mountpoint MOUNTPOINT|HOST:MOUNTPOINT... [OPTIONS]",
+		Short:   "show real time performance statistics of mountpoints",
+		Args:    utils.RequiresMinArgs(1),
 		Example: STATS_MOUNTPOINT_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
-			options.mountpoint = args[0]
+			options.mountpoints = args
 
 			options.schema, err = cmd.Flags().GetString("schema")
 			if err != nil {
@@ -140,6 +148,14 @@ func NewStatsMountpointCommand(dingocli *cli.DingoCli) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			options.record, err = cmd.Flags().GetString("record")
+			if err != nil {
+				return err
+			}
+			options.format, err = cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
 
 			return runMountpoint(cmd, dingocli, options)
 		},
@@ -154,15 +170,17 @@ func NewStatsMountpointCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.Flags().String("schema", "ufbor", `Schema string that controls the output sections (u: usage, f: fuse, b: blockcache, o: object, r:remotecache) (default "ufbor")"`)
 	cmd.Flags().Uint32P("count", "c", 0, "Max outout count(0 is unlimited)")
 	cmd.Flags().BoolP("verbose", "v", false, "Show more info")
+	cmd.Flags().String("record", "", "Record every sample with raw counters into file (jsonl), which can be replayed by 'dingo fs stats replay'")
+	cmd.Flags().String("format", STATS_FORMAT_TABLE, "Output format (table|csv)")
 
 	return cmd
 }
 
 func runMountpoint(cmd *cobra.Command, dingocli *cli.DingoCli, options mountpointOptions) error {
-
-	realTimeStats(options.mountpoint, options.schema, options.verbose, options.interval, options.count)
-
-	return nil
+	if err := checkStatsFormat(options.format); err != nil {
+		return err
+	}
+	return realTimeStats(dingocli, options)
 }
 
 func (w *statsWatcher) colorize(msg string, color int, dark bool, underline bool) string {
@@ -187,38 +205,38 @@ func (w *statsWatcher) buildSchema(schema string, verbose bool) {
 		switch r {
 		case 'u':
 			s.name = "usage"
-			s.items = append(s.items, &item{"cpu", "process_cpu_usage", metricCPU | metricCounter})
-			s.items = append(s.items, &item{"mem", "process_memory_resident", metricGauge})
-			s.items = append(s.items, &item{"used", "dingofs_memory_used_bytes", metricGauge})
+			s.items = append(s.items, &item{"cpu", "process_cpu_usage", MetricCPU | MetricCounter})
+			s.items = append(s.items, &item{"mem", "process_memory_resident", MetricGauge})
+			s.items = append(s.items, &item{"used", "dingofs_memory_used_bytes", MetricGauge})
 			if verbose {
-				s.items = append(s.items, &item{"total", "dingofs_memory_total_bytes", metricGauge})
+				s.items = append(s.items, &item{"total", "dingofs_memory_total_bytes", MetricGauge})
 			}
 		case 'f':
 			s.name = "fuse"
-			s.items = append(s.items, &item{"ops", "dingofs_fuse_op_all", metricTime | metricHist})
-			s.items = append(s.items, &item{"read", "dingofs_vfs_read_bps_total_count", metricByte | metricCounter})
-			s.items = append(s.items, &item{"write", "dingofs_vfs_write_bps_total_count", metricByte | metricCounter})
+			s.items = append(s.items, &item{"ops", "dingofs_fuse_op_all", MetricTime | MetricHist})
+			s.items = append(s.items, &item{"read", "dingofs_vfs_read_bps_total_count", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"write", "dingofs_vfs_write_bps_total_count", MetricByte | MetricCounter})
 		case 'b':
 			s.name = "blockcache"
-			s.items = append(s.items, &item{"load", "dingofs_disk_cache_group_load_total_bytes", metricByte | metricCounter})
-			s.items = append(s.items, &item{"stage", "dingofs_disk_cache_group_stage_total_bytes", metricByte | metricCounter})
-			s.items = append(s.items, &item{"cache", "dingofs_disk_cache_group_cache_total_bytes", metricByte | metricCounter})
+			s.items = append(s.items, &item{"load", "dingofs_disk_cache_group_load_total_bytes", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"stage", "dingofs_disk_cache_group_stage_total_bytes", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"cache", "dingofs_disk_cache_group_cache_total_bytes", MetricByte | MetricCounter})
 		case 'o':
 			s.name = "object"
-			s.items = append(s.items, &item{"get", "dingofs_block_read_block_bps_total_count", metricByte | metricCounter})
+			s.items = append(s.items, &item{"get", "dingofs_block_read_block_bps_total_count", MetricByte | MetricCounter})
 			if verbose {
-				s.items = append(s.items, &item{"ops", "dingofs_block_read_block", metricTime | metricHist})
+				s.items = append(s.items, &item{"ops", "dingofs_block_read_block", MetricTime | MetricHist})
 			}
-			s.items = append(s.items, &item{"put", "dingofs_block_write_block_bps_total_count", metricByte | metricCounter})
+			s.items = append(s.items, &item{"put", "dingofs_block_write_block_bps_total_count", MetricByte | MetricCounter})
 			if verbose {
-				s.items = append(s.items, &item{"ops", "dingofs_block_write_block", metricTime | metricHist})
+				s.items = append(s.items, &item{"ops", "dingofs_block_write_block", MetricTime | MetricHist})
 			}
 		case 'r':
 			s.name = "remotecache"
-			s.items = append(s.items, &item{"load", "dingofs_remote_node_group_range_total_bytes", metricByte | metricCounter})
-			s.items = append(s.items, &item{"stage", "dingofs_remote_node_group_put_total_bytes", metricByte | metricCounter})
-			s.items = append(s.items, &item{"cache", "dingofs_remote_node_group_cache_total_bytes", metricByte | metricCounter})
-			s.items = append(s.items, &item{"hit", "dingofs_remote_cache", metricHit})
+			s.items = append(s.items, &item{"load", "dingofs_remote_node_group_range_total_bytes", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"stage", "dingofs_remote_node_group_put_total_bytes", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"cache", "dingofs_remote_node_group_cache_total_bytes", MetricByte | MetricCounter})
+			s.items = append(s.items, &item{"hit", "dingofs_remote_cache", MetricHit})
 		default:
 			fmt.Printf("Warning: no item defined for %c\n", r)
 			continue
@@ -256,8 +274,8 @@ func (w *statsWatcher) formatHeader() {
 		subs := make([]string, 0, len(s.items))
 		for _, it := range s.items {
 			subs = append(subs, w.colorize(padding(it.nick, MaxItemSize, ' '), BLUE, false, true))
-			if it.typ&metricHist != 0 {
-				if it.typ&metricTime != 0 {
+			if it.typ&MetricHist != 0 {
+				if it.typ&MetricTime != 0 {
 					subs = append(subs, w.colorize(" lat ", BLUE, false, true))
 				} else {
 					subs = append(subs, w.colorize(" avg ", BLUE, false, true))
@@ -348,60 +366,35 @@ func (w *statsWatcher) formatHits(v float64, dark bool) string {
 }
 
 // read metric data from file
-func readStats(mp string) map[string]float64 {
-
+func readStats(mp string) (map[string]float64, error) {
 	f, err := os.Open(filepath.Join(mp, ".stats"))
 	if err != nil {
-		log.Fatalf("open stats file under mount point %s: %s", mp, err)
+		return nil, fmt.Errorf("open stats file under mount point %s: %s", mp, err)
 	}
 	defer f.Close()
 	data, err := io.ReadAll(f)
 	if err != nil {
-		log.Fatalf("read stats file under mount point %s: %s", mp, err)
-	}
-
-	if err != nil {
-		panic(err)
-	}
-
-	outstr := strings.ReplaceAll(string(data), "\r", "")
-	outstr = strings.ReplaceAll(outstr, " ", "")
-
-	metricDataMap := make(map[string]float64)
-	lines := strings.Split(string(outstr), "\n")
-
-	for _, line := range lines {
-		fields := strings.Split(line, ":")
-		if len(fields) == 2 {
-			v, err := strconv.ParseFloat(fields[1], 64)
-			if err != nil {
-				continue
-			}
-			metricDataMap[fields[0]] = v
-		}
+		return nil, fmt.Errorf("read stats file under mount point %s: %s", mp, err)
 	}
-	return metricDataMap
+	return utils.ParseStats(string(data)), nil
 }
 
-func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
-	if !w.colorful && dark {
-		return
-	}
-	values := make([]string, len(w.sections))
+// diffValues calculates the value of every column between two samples,
+// the hist item has two columns: count and average latency
+func (w *statsWatcher) diffValues(left, right map[string]float64, dark bool) [][]float64 {
+	values := make([][]float64, len(w.sections))
 	for i, s := range w.sections {
-		vals := make([]string, 0, len(s.items))
+		vals := make([]float64, 0, len(s.items))
 		for _, it := range s.items {
 			switch it.typ & 0xF0 {
-			case metricGauge: // show current value
-				vals = append(vals, w.formatU64(right[it.name], dark, true))
-			case metricCounter:
+			case MetricGauge: // show current value
+				vals = append(vals, right[it.name])
+			case MetricCounter:
 				v := (right[it.name] - left[it.name])
 				if !dark {
 					v /= float64(w.interval)
 				}
-				if it.typ&metricByte != 0 {
-					vals = append(vals, w.formatU64(v, dark, true))
-				} else if it.typ&metricCPU != 0 {
+				if it.typ&MetricCPU != 0 {
 					v = right[it.name] //reset value to current for cpu
 					w.cpuUsage += v
 					if !dark {
@@ -409,18 +402,14 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 						v /= float64(w.interval)
 						w.cpuUsage = 0.0
 					}
-					vals = append(vals, w.formatCPU(v, dark))
-				} else if it.typ&metricTime != 0 {
-					vals = append(vals, w.formatTime(v, dark))
-				} else { // metricCount
-					vals = append(vals, w.formatU64(v, dark, false))
 				}
-			case metricHist: // metricTime
+				vals = append(vals, v)
+			case MetricHist: // MetricTime
 				count := right[it.name+"_qps_total_count"] - left[it.name+"_qps_total_count"]
 				var avg float64
 				if count > 0.0 {
 					latency := right[it.name+"_lat_total_value"] - left[it.name+"_lat_total_value"]
-					if it.typ&metricTime != 0 {
+					if it.typ&MetricTime != 0 {
 						latency /= 1000 //us -> ms
 					}
 					avg = latency / count
@@ -428,9 +417,9 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 				if !dark {
 					count /= float64(w.interval)
 				}
-				vals = append(vals, w.formatU64(count, dark, false), w.formatTime(avg, dark))
+				vals = append(vals, count, avg)
 
-			case metricHit: // metricHits
+			case MetricHit: // metricHits
 				hitCount := right[it.name+"_hit_count"] - left[it.name+"_hit_count"]
 				missCount := right[it.name+"_miss_count"] - left[it.name+"_miss_count"]
 				totalCount := hitCount + missCount
@@ -438,63 +427,195 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 				if totalCount > 0.0 {
 					avg = hitCount / totalCount
 				}
-				vals = append(vals, w.formatHits(avg, dark))
+				vals = append(vals, avg)
 			}
 		}
-		values[i] = strings.Join(vals, " ")
+		values[i] = vals
 	}
-	if w.colorful && dark {
-		fmt.Printf("%s\r", strings.Join(values, w.colorize("|", BLUE, true, false)))
-	} else {
-		fmt.Printf("%s\n", strings.Join(values, w.colorize("|", BLUE, true, false)))
+	return values
+}
+
+func (w *statsWatcher) formatValues(values [][]float64, dark bool) string {
+	lines := make([]string, len(w.sections))
+	for i, s := range w.sections {
+		vals := make([]string, 0, len(values[i]))
+		k := 0
+		for _, it := range s.items {
+			v := values[i][k]
+			k++
+			switch it.typ & 0xF0 {
+			case MetricGauge:
+				vals = append(vals, w.formatU64(v, dark, true))
+			case MetricCounter:
+				if it.typ&MetricByte != 0 {
+					vals = append(vals, w.formatU64(v, dark, true))
+				} else if it.typ&MetricCPU != 0 {
+					vals = append(vals, w.formatCPU(v, dark))
+				} else if it.typ&MetricTime != 0 {
+					vals = append(vals, w.formatTime(v, dark))
+				} else { // MetricCount
+					vals = append(vals, w.formatU64(v, dark, false))
+				}
+			case MetricHist:
+				vals = append(vals, w.formatU64(v, dark, false), w.formatTime(values[i][k], dark))
+				k++
+			case MetricHit:
+				vals = append(vals, w.formatHits(v, dark))
+			}
+		}
+		lines[i] = strings.Join(vals, " ")
 	}
+	return strings.Join(lines, w.colorize("|", BLUE, true, false))
 }
 
-// real time read metric data and show in client
-func realTimeStats(mountPoint string, schema string, verbose bool, duration time.Duration, count uint32) {
-	inode, err := utils.GetFileInode(mountPoint)
-	if err != nil {
-		log.Fatalf("run stats failed, %s", err)
+func (w *statsWatcher) printDiff(label string, left, right map[string]float64, dark bool) {
+	if !w.colorful && dark {
+		return
 	}
-	if inode != 1 {
-		log.Fatalf("path %s is not a mount point", mountPoint)
+	values := w.diffValues(left, right, dark)
+	if !dark {
+		w.printRow(time.Now(), label, values)
+	} else if w.format != STATS_FORMAT_CSV && w.labelWidth == 0 {
+		fmt.Printf("%s\r", w.formatValues(values, dark))
 	}
+}
+
+func checkStatsTargets(targets []*statsTarget) {
+	for _, target := range targets {
+		if !target.isLocal() {
+			continue
+		}
+		inode, err := utils.GetFileInode(target.mountPoint)
+		if err != nil {
+			log.Fatalf("run stats failed, %s", err)
+		}
+		if inode != 1 {
+			log.Fatalf("path %s is not a mount point", target.mountPoint)
+		}
+	}
+}
+
+// real time read metric data and show in client
+func realTimeStats(dingocli *cli.DingoCli, options mountpointOptions) error {
+	var err error
+	targets := parseStatsTargets(options.mountpoints)
+	checkStatsTargets(targets)
 	watcher := &statsWatcher{
-		colorful:   isatty.IsTerminal(os.Stdout.Fd()),
-		duration:   duration,
-		mountPoint: mountPoint,
-		interval:   int64(duration) / 1000000000,
+		colorful:   isatty.IsTerminal(os.Stdout.Fd()) && options.format != STATS_FORMAT_CSV,
+		duration:   options.interval,
+		mountPoint: targets[0].name,
+		interval:   int64(options.interval) / 1000000000,
 		cpuUsage:   0.0,
-		count:      count,
+		count:      options.count,
+		format:     options.format,
 	}
-	watcher.buildSchema(schema, verbose)
+	watcher.buildSchema(options.schema, options.verbose)
 	watcher.formatHeader()
 
+	var recorder *statsRecorder
+	if len(options.record) > 0 {
+		if recorder, err = newStatsRecorder(options.record); err != nil {
+			return err
+		}
+		defer recorder.close()
+	}
+	return watchTargets(dingocli, watcher, targets, recorder)
+}
+
+// watchTargets reads targets every second and prints a row every interval,
+// for several targets every target has a row and the total row is
+// calculated from the sum of counters
+func watchTargets(dingocli *cli.DingoCli, watcher *statsWatcher, targets []*statsTarget, recorder *statsRecorder) error {
+	var err error
+	multiple := len(targets) > 1
+	if multiple {
+		watcher.labelWidth = len(STATS_AGGREGATE_TARGET)
+		for _, target := range targets {
+			watcher.labelWidth = max(watcher.labelWidth, len(target.name)+1) // +1 for failed mark
+		}
+	}
+	for _, target := range targets {
+		tw := *watcher
+		target.watcher = &tw
+	}
+
+	current := func(t *statsTarget) map[string]float64 { return t.current }
+	start := func(t *statsTarget) map[string]float64 { return t.start }
+	last := func(t *statsTarget) map[string]float64 { return t.last }
+
+	read := func(wait time.Duration) error {
+		readTargets(dingocli, targets, wait)
+		for _, target := range targets {
+			if target.current == nil { // the first read failed
+				return target.err
+			}
+		}
+		if recorder == nil {
+			return nil
+		}
+		for _, target := range targets {
+			if target.err != nil {
+				continue
+			}
+			if err := recorder.record(target.name, watcher.interval, target.current); err != nil {
+				return err
+			}
+		}
+		if multiple { // the total row is replayed as another target
+			return recorder.record(STATS_AGGREGATE_TARGET, watcher.interval, sumStats(targets, current))
+		}
+		return nil
+	}
+
 	var tick uint
-	var start, last, current map[string]float64
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
-	current = readStats(watcher.mountPoint)
-	start = current
-	last = current
+	if err = read(0); err != nil { // wait for the first counters of all targets
+		return err
+	}
+	for _, target := range targets {
+		target.start = target.current
+		target.last = target.current
+	}
 	for {
 		if tick%(uint(watcher.interval)*30) == 0 {
-			fmt.Println(watcher.header)
+			watcher.printHeader()
 		}
-		if tick%uint(watcher.interval) == 0 {
-			watcher.printDiff(start, current, false)
-			start = current
-		} else {
-			watcher.printDiff(last, current, true)
+		dark := tick%uint(watcher.interval) != 0
+		if !multiple {
+			target := targets[0]
+			if !dark {
+				watcher.printDiff(target.name, target.start, target.current, false)
+				target.start = target.current
+			} else {
+				watcher.printDiff(target.name, target.last, target.current, true)
+			}
+		} else if !dark {
+			for _, target := range targets {
+				target.watcher.printDiff(target.label(), target.start, target.current, false)
+			}
+			watcher.printDiff(STATS_AGGREGATE_TARGET, sumStats(targets, start), sumStats(targets, current), false)
+			for _, target := range targets {
+				target.start = target.current
+			}
+		} else { // only accumulate cpu usage
+			for _, target := range targets {
+				target.watcher.diffValues(target.last, target.current, true)
+			}
+			watcher.diffValues(sumStats(targets, last), sumStats(targets, current), true)
+		}
+		for _, target := range targets {
+			target.last = target.current
 		}
-		last = current
 		tick++
 		<-ticker.C
-		current = readStats(watcher.mountPoint)
+		if err = read(STATS_READ_WAIT); err != nil {
+			return err
+		}
 		//for interval > 1s,don't print the middle result for last time
 		if uint(math.Ceil(float64(tick)/float64(watcher.interval))) == uint(watcher.count) { //exit
 			break
 		}
 	}
-
+	return nil
 }
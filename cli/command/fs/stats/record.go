@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stats
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/errno"
+)
+
+const (
+	STATS_FORMAT_TABLE = "table"
+	STATS_FORMAT_CSV   = "csv"
+
+	STATS_TIME_FORMAT = "15:04:05"
+)
+
+// statsSample is one line of the record file, which holds the raw counters
+// read from .stats, so the record can be replayed with any schema.
+// The samples written by one run share the same session, the counters
+// of different sessions can't be diffed as the record file is appended.
+type statsSample struct {
+	Time       time.Time          `json:"time"`
+	Session    int64              `json:"session"`
+	MountPoint string             `json:"mountpoint"`
+	Interval   int64              `json:"interval"`
+	Counters   map[string]float64 `json:"counters"`
+}
+
+type statsRecorder struct {
+	file    *os.File
+	encoder *json.Encoder
+	session int64
+}
+
+func checkStatsFormat(format string) error {
+	if format != STATS_FORMAT_TABLE && format != STATS_FORMAT_CSV {
+		return errno.ERR_UNSUPPORT_STATS_FORMAT.F("format: %s", format)
+	}
+	return nil
+}
+
+func newStatsRecorder(filename string) (*statsRecorder, error) {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errno.ERR_RECORD_STATS_FAILED.E(err)
+	}
+	return &statsRecorder{file: file, encoder: json.NewEncoder(file), session: time.Now().UnixNano()}, nil
+}
+
+func (r *statsRecorder) record(mountPoint string, interval int64, counters map[string]float64) error {
+	err := r.encoder.Encode(statsSample{
+		Time:       time.Now(),
+		Session:    r.session,
+		MountPoint: mountPoint,
+		Interval:   interval,
+		Counters:   counters,
+	})
+	if err != nil {
+		return errno.ERR_RECORD_STATS_FAILED.E(err)
+	}
+	return nil
+}
+
+func (r *statsRecorder) close() {
+	r.file.Close()
+}
+
+// readStatsRecord reads all samples of the record file
+func readStatsRecord(filename string) ([]statsSample, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, errno.ERR_READ_STATS_RECORD_FAILED.E(err)
+	}
+	defer file.Close()
+
+	samples := []statsSample{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		var sample statsSample
+		if err := json.Unmarshal([]byte(line), &sample); err != nil {
+			return nil, errno.ERR_READ_STATS_RECORD_FAILED.F("%s:%d: %v", filename, lineno, err)
+		}
+		samples = append(samples, sample)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errno.ERR_READ_STATS_RECORD_FAILED.E(err)
+	}
+	return samples, nil
+}
+
+func (w *statsWatcher) printHeader() {
+	if w.format == STATS_FORMAT_CSV {
+		return
+	}
+	lines := strings.SplitN(w.header, "\n", 2)
+	if w.labelWidth > 0 {
+		lines[0] = fmt.Sprintf("%s %s", strings.Repeat(" ", w.labelWidth), lines[0])
+		lines[1] = fmt.Sprintf("%s %s", w.colorize(padding("target", w.labelWidth, ' '), BLUE, false, true), lines[1])
+	}
+	if w.timestamp {
+		lines[0] = fmt.Sprintf("%s %s", strings.Repeat(" ", len(STATS_TIME_FORMAT)), lines[0])
+		lines[1] = fmt.Sprintf("%s %s", w.colorize(padding("time", len(STATS_TIME_FORMAT), ' '), BLUE, false, true), lines[1])
+	}
+	fmt.Printf("%s\n%s\n", lines[0], lines[1])
+}
+
+// printRow prints the values of one interval, the target is only shown
+// in table when there are several targets.
+func (w *statsWatcher) printRow(t time.Time, target string, values [][]float64) {
+	if w.format == STATS_FORMAT_CSV {
+		w.writeCSV(t, target, values)
+		return
+	}
+	line := w.formatValues(values, false)
+	if w.labelWidth > 0 {
+		line = fmt.Sprintf("%-*s %s", w.labelWidth, target, line)
+	}
+	if w.timestamp {
+		line = fmt.Sprintf("%s %s", t.Format(STATS_TIME_FORMAT), line)
+	}
+	fmt.Println(line)
+}
+
+// csvColumns returns the column names, the metric name is used for uniqueness
+func (w *statsWatcher) csvColumns() []string {
+	columns := []string{"time", "target"}
+	for _, s := range w.sections {
+		for _, it := range s.items {
+			switch {
+			case it.typ&MetricHist != 0:
+				suffix := "_avg"
+				if it.typ&MetricTime != 0 {
+					suffix = "_lat_ms"
+				}
+				columns = append(columns, it.name+"_qps", it.name+suffix)
+			case it.typ&MetricHit != 0:
+				columns = append(columns, it.name+"_hit_ratio")
+			default:
+				columns = append(columns, it.name)
+			}
+		}
+	}
+	return columns
+}
+
+// csvRecord returns the cells of one row in the same order as csvColumns
+func (w *statsWatcher) csvRecord(t time.Time, target string, values [][]float64) []string {
+	record := []string{t.Format(time.RFC3339), target}
+	for _, vals := range values {
+		for _, v := range vals {
+			record = append(record, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+	return record
+}
+
+func (w *statsWatcher) writeCSV(t time.Time, target string, values [][]float64) {
+	writer := csv.NewWriter(os.Stdout)
+	if !w.csvHeader {
+		writer.Write(w.csvColumns())
+		w.csvHeader = true
+	}
+	writer.Write(w.csvRecord(t, target, values))
+	writer.Flush()
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stats
+
+import (
+	"os"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STATS_REPLAY_EXAMPLE = `Examples:
+   $ dingo fs stats replay stats.jsonl
+   $ dingo fs stats replay stats.jsonl --since 5m --until 10m
+   $ dingo fs stats replay stats.jsonl --since "2026-01-02 15:04:05" --format csv > stats.csv`
+)
+
+type replayOptions struct {
+	filename string
+	schema   string
+	interval time.Duration
+	since    string
+	until    string
+	verbose  bool
+	format   string
+}
+
+func NewStatsReplayCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options replayOptions
+
+	cmd := &cobra.Command{
+		Use:     "replay FILE [OPTIONS]",
+		Short:   "replay performance statistics recorded by 'dingo fs stats mountpoint --record'",
+		Args:    utils.ExactArgs(1),
+		Example: STATS_REPLAY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.filename = args[0]
+			return runReplay(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.DurationVarP(&options.interval, "interval", "i", 0, "Interval time for every output (default the recorded interval)")
+	flags.StringVar(&options.schema, "schema", "ufbor", "Schema string that controls the output sections (u: usage, f: fuse, b: blockcache, o: object, r:remotecache)")
+	flags.StringVar(&options.since, "since", "", "Replay samples since timestamp (e.g. 2026-01-02T15:04:05) or offset from the record start (e.g. 5m)")
+	flags.StringVar(&options.until, "until", "", "Replay samples until timestamp (e.g. 2026-01-02T15:04:05) or offset from the record start (e.g. 10m)")
+	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Show more info")
+	flags.StringVar(&options.format, "format", STATS_FORMAT_TABLE, "Output format (table|csv)")
+
+	return cmd
+}
+
+// parseRecordTime parses the timestamp or offset from the start of record
+func parseRecordTime(value string, start time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return start.Add(d), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errno.ERR_INVALID_STATS_TIME_RANGE.F("time: %s", value)
+}
+
+func filterSamples(samples []statsSample, options replayOptions) ([]statsSample, error) {
+	start := samples[0].Time
+	since, err := parseRecordTime(options.since, start)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseRecordTime(options.until, start)
+	if err != nil {
+		return nil, err
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, errno.ERR_INVALID_STATS_TIME_RANGE.F("since %s is after until %s", options.since, options.until)
+	}
+
+	ret := []statsSample{}
+	for _, sample := range samples {
+		if !since.IsZero() && sample.Time.Before(since) {
+			continue
+		} else if !until.IsZero() && sample.Time.After(until) {
+			continue
+		}
+		ret = append(ret, sample)
+	}
+	return ret, nil
+}
+
+func runReplay(cmd *cobra.Command, dingocli *cli.DingoCli, options replayOptions) error {
+	if err := checkStatsFormat(options.format); err != nil {
+		return err
+	}
+
+	// 1) read and filter samples
+	samples, err := readStatsRecord(options.filename)
+	if err != nil {
+		return err
+	} else if len(samples) == 0 {
+		return errno.ERR_READ_STATS_RECORD_FAILED.F("no samples in %s", options.filename)
+	}
+	samples, err = filterSamples(samples, options)
+	if err != nil {
+		return err
+	} else if len(samples) == 0 {
+		return errno.ERR_INVALID_STATS_TIME_RANGE.F("no samples in the time range")
+	}
+
+	// 2) render samples like the real time stats
+	interval := int64(options.interval / time.Second)
+	if interval <= 0 {
+		interval = samples[0].Interval
+	}
+	if interval <= 0 {
+		interval = 1
+	}
+	watcher := &statsWatcher{
+		colorful:   isatty.IsTerminal(os.Stdout.Fd()) && options.format != STATS_FORMAT_CSV,
+		duration:   time.Duration(interval) * time.Second,
+		mountPoint: samples[0].MountPoint,
+		interval:   interval,
+		format:     options.format,
+		timestamp:  true,
+	}
+	watcher.buildSchema(options.schema, options.verbose)
+	watcher.formatHeader()
+
+	// samples of several targets (host:mountpoint) are replayed one by one
+	groups := groupSamples(samples)
+	if len(groups) > 1 {
+		for _, group := range groups {
+			watcher.labelWidth = max(watcher.labelWidth, len(group[0].MountPoint))
+		}
+	}
+	for _, group := range groups {
+		replaySamples(watcher, group)
+	}
+	return nil
+}
+
+// groupSamples groups samples by target in the order of first appearance
+func groupSamples(samples []statsSample) [][]statsSample {
+	index := map[string]int{}
+	groups := [][]statsSample{}
+	for _, sample := range samples {
+		i, ok := index[sample.MountPoint]
+		if !ok {
+			i = len(groups)
+			index[sample.MountPoint] = i
+			groups = append(groups, []statsSample{})
+		}
+		groups[i] = append(groups[i], sample)
+	}
+	return groups
+}
+
+// splitSessions splits samples of one target by the session of recording
+func splitSessions(samples []statsSample) [][]statsSample {
+	sessions := [][]statsSample{}
+	for i, sample := range samples {
+		if i == 0 || sample.Session != samples[i-1].Session {
+			sessions = append(sessions, []statsSample{})
+		}
+		sessions[len(sessions)-1] = append(sessions[len(sessions)-1], sample)
+	}
+	return sessions
+}
+
+func replaySamples(watcher *statsWatcher, samples []statsSample) {
+	var rows int64
+	interval := watcher.interval
+	watcher.printHeader()
+	for _, session := range splitSessions(samples) {
+		var tick int64
+		start, last := session[0], session[0]
+		watcher.cpuUsage = 0.0
+		for _, current := range session[1:] {
+			tick++
+			if tick%interval == 0 {
+				if rows > 0 && rows%30 == 0 {
+					watcher.printHeader()
+				}
+				watcher.printRow(current.Time, current.MountPoint, watcher.diffValues(start.Counters, current.Counters, false))
+				start = current
+				rows++
+			} else {
+				watcher.diffValues(last.Counters, current.Counters, true) // accumulate cpu usage
+			}
+			last = current
+		}
+	}
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newSamples(start time.Time, mountPoint string, n int) []statsSample {
+	samples := []statsSample{}
+	for i := 0; i < n; i++ {
+		samples = append(samples, statsSample{
+			Time:       start.Add(time.Duration(i) * time.Second),
+			MountPoint: mountPoint,
+			Interval:   1,
+			Counters:   map[string]float64{},
+		})
+	}
+	return samples
+}
+
+/*
+ * TestParseRecordTime, run: go test ./cli/command/fs/stats -run ^TestParseRecordTime$
+ */
+func TestParseRecordTime(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.Local)
+	tests := []struct {
+		name   string
+		value  string
+		expect time.Time
+		err    bool
+	}{
+		{"empty", "", time.Time{}, false},
+		{"offset", "5m", start.Add(5 * time.Minute), false},
+		{"zero offset", "0s", start, false},
+		{"rfc3339", "2026-01-02T15:04:05Z", time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{"local", "2026-01-02T15:04:05", time.Date(2026, 1, 2, 15, 4, 5, 0, time.Local), false},
+		{"local with space", "2026-01-02 15:04:05", time.Date(2026, 1, 2, 15, 4, 5, 0, time.Local), false},
+		{"negative offset", "-5m", time.Time{}, true},
+		{"invalid", "yesterday", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRecordTime(tt.value, start)
+			if (err != nil) != tt.err {
+				t.Fatalf("parseRecordTime(%q) error = %v, expect error %v", tt.value, err, tt.err)
+			}
+			if !got.Equal(tt.expect) {
+				t.Errorf("parseRecordTime(%q) = %v, expect %v", tt.value, got, tt.expect)
+			}
+		})
+	}
+}
+
+/*
+ * TestFilterSamples, run: go test ./cli/command/fs/stats -run ^TestFilterSamples$
+ */
+func TestFilterSamples(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.Local)
+	samples := newSamples(start, "/mnt/dingofs", 10)
+	tests := []struct {
+		name   string
+		since  string
+		until  string
+		expect []int // index of samples
+		err    bool
+	}{
+		{"all", "", "", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, false},
+		{"since offset", "7s", "", []int{7, 8, 9}, false},
+		{"until offset", "", "2s", []int{0, 1, 2}, false},
+		{"range", "3s", "5s", []int{3, 4, 5}, false},
+		{"timestamp", "2026-01-02 15:00:08", "", []int{8, 9}, false},
+		{"out of range", "1h", "", []int{}, false},
+		{"since after until", "5s", "3s", nil, true},
+		{"invalid", "abc", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := filterSamples(samples, replayOptions{since: tt.since, until: tt.until})
+			if (err != nil) != tt.err {
+				t.Fatalf("filterSamples() error = %v, expect error %v", err, tt.err)
+			} else if tt.err {
+				return
+			}
+			if len(got) != len(tt.expect) {
+				t.Fatalf("filterSamples() got %d samples, expect %d", len(got), len(tt.expect))
+			}
+			for i, idx := range tt.expect {
+				if !got[i].Time.Equal(samples[idx].Time) {
+					t.Errorf("sample %d: time = %v, expect %v", i, got[i].Time, samples[idx].Time)
+				}
+			}
+		})
+	}
+}
+
+/*
+ * TestGroupSamples, run: go test ./cli/command/fs/stats -run ^TestGroupSamples$
+ */
+func TestGroupSamples(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.Local)
+	a := newSamples(start, "host2:/mnt/dingofs", 3)
+	b := newSamples(start, "host1:/mnt/dingofs", 2)
+	// samples of targets are interleaved in the record file
+	samples := []statsSample{a[0], b[0], a[1], b[1], a[2]}
+
+	groups := groupSamples(samples)
+	if len(groups) != 2 {
+		t.Fatalf("groupSamples() got %d groups, expect 2", len(groups))
+	}
+	// groups keep the order of first appearance instead of sorting by target
+	if !reflect.DeepEqual(groups[0], a) {
+		t.Errorf("group 0 = %v, expect %v", groups[0], a)
+	}
+	if !reflect.DeepEqual(groups[1], b) {
+		t.Errorf("group 1 = %v, expect %v", groups[1], b)
+	}
+}
+
+/*
+ * TestSplitSessions, run: go test ./cli/command/fs/stats -run ^TestSplitSessions$
+ */
+func TestSplitSessions(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.Local)
+	samples := newSamples(start, "/mnt/dingofs", 6)
+	samples[3].Time = start.Add(time.Hour) // a slow read doesn't start a new session
+	for i := range samples {
+		samples[i].Session = 1
+		if i >= 4 {
+			samples[i].Session = 2 // appended by another run
+		}
+	}
+
+	sessions := splitSessions(samples)
+	expect := [][]statsSample{samples[:4], samples[4:]}
+	if !reflect.DeepEqual(sessions, expect) {
+		t.Errorf("splitSessions() = %v, expect %v", sessions, expect)
+	}
+}
+
+/*
+ * TestCSVColumns, run: go test ./cli/command/fs/stats -run ^TestCSVColumns$
+ */
+func TestCSVColumns(t *testing.T) {
+	w := &statsWatcher{interval: 1, format: STATS_FORMAT_CSV}
+	w.buildSchema("ufr", false)
+
+	expect := []string{
+		"time", "target",
+		"process_cpu_usage", "process_memory_resident", "dingofs_memory_used_bytes",
+		"dingofs_fuse_op_all_qps", "dingofs_fuse_op_all_lat_ms",
+		"dingofs_vfs_read_bps_total_count", "dingofs_vfs_write_bps_total_count",
+		"dingofs_remote_node_group_range_total_bytes", "dingofs_remote_node_group_put_total_bytes",
+		"dingofs_remote_node_group_cache_total_bytes", "dingofs_remote_cache_hit_ratio",
+	}
+	columns := w.csvColumns()
+	if !reflect.DeepEqual(columns, expect) {
+		t.Fatalf("csvColumns() = %v, expect %v", columns, expect)
+	}
+
+	// every row must have the same number of cells as the header
+	left := map[string]float64{
+		"dingofs_fuse_op_all_qps_total_count":       10,
+		"dingofs_fuse_op_all_lat_total_value":       1000,
+		"dingofs_vfs_read_bps_total_count":          100,
+		"dingofs_remote_cache_hit_count":            1,
+		"dingofs_remote_cache_miss_count":           1,
+		"dingofs_remote_node_group_put_total_bytes": 0,
+	}
+	right := map[string]float64{
+		"process_cpu_usage":                         0.5,
+		"process_memory_resident":                   1024,
+		"dingofs_fuse_op_all_qps_total_count":       20,
+		"dingofs_fuse_op_all_lat_total_value":       21000,
+		"dingofs_vfs_read_bps_total_count":          4196,
+		"dingofs_remote_cache_hit_count":            4,
+		"dingofs_remote_cache_miss_count":           2,
+		"dingofs_remote_node_group_put_total_bytes": 0,
+	}
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	record := w.csvRecord(now, "/mnt/dingofs", w.diffValues(left, right, false))
+	if len(record) != len(columns) {
+		t.Fatalf("csvRecord() got %d cells, expect %d", len(record), len(columns))
+	}
+	cells := map[string]string{}
+	for i, column := range columns {
+		cells[column] = record[i]
+	}
+	for column, value := range map[string]string{
+		"time":                             "2026-01-02T15:04:05Z",
+		"target":                           "/mnt/dingofs",
+		"process_cpu_usage":                "0.5",
+		"process_memory_resident":          "1024",
+		"dingofs_fuse_op_all_qps":          "10",
+		"dingofs_fuse_op_all_lat_ms":       "2",
+		"dingofs_vfs_read_bps_total_count": "4096",
+		"dingofs_remote_cache_hit_ratio":   "0.75",
+	} {
+		if cells[column] != value {
+			t.Errorf("column %s = %s, expect %s", column, cells[column], value)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	STATS_AGGREGATE_TARGET = "total"
+	STATS_REMOTE_TIMEOUT   = 3 * time.Second
+	STATS_READ_WAIT        = 800 * time.Millisecond // below the 1s tick of watching
+)
+
+// statsTarget is a mountpoint on local or remote host (host:mountpoint),
+// the .stats of remote mountpoint is read over SSH through the hosts inventory.
+// The target with reader reads counters from other source (e.g. mds /vars).
+type statsTarget struct {
+	name       string
+	host       string // empty means local
+	mountPoint string
+	reader     func() (map[string]float64, error)
+	watcher    *statsWatcher // keeps the cpu usage accumulated for the target
+	start      map[string]float64
+	last       map[string]float64
+	current    map[string]float64
+	err        error
+
+	// result of the background read, applied to current/err by readTargets
+	mutex     sync.Mutex
+	reading   bool
+	result    map[string]float64
+	resultErr error
+}
+
+func parseStatsTargets(args []string) []*statsTarget {
+	targets := []*statsTarget{}
+	for _, arg := range args {
+		target := &statsTarget{name: arg, mountPoint: arg}
+		if idx := strings.Index(arg, ":"); idx > 0 && !strings.HasPrefix(arg, "/") {
+			target.host = arg[:idx]
+			target.mountPoint = arg[idx+1:]
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+func (t *statsTarget) isLocal() bool {
+	return len(t.host) == 0
+}
+
+func (t *statsTarget) label() string {
+	if t.err != nil {
+		return "!" + t.name
+	}
+	return t.name
+}
+
+func (t *statsTarget) readRemote(dingocli *cli.DingoCli) (map[string]float64, error) {
+	hc, err := dingocli.GetHost(t.host)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := module.GlobalSSHPool()
+	client, err := pool.Get(*hc.GetSSHConfig())
+	if err != nil {
+		return nil, errno.ERR_SSH_CONNECT_FAILED.E(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), STATS_REMOTE_TIMEOUT)
+	defer cancel()
+	m := module.NewModule(ctx, client)
+	out, err := m.Shell().Cat(path.Join(t.mountPoint, ".stats")).Execute(dingocli.ExecOptions())
+	if err != nil {
+		pool.Evict(client) // the session may be broken or still running after timeout
+		return nil, fmt.Errorf("read stats file under mount point %s: %s", t.name, err)
+	}
+	pool.Put(client)
+	return utils.ParseStats(out), nil
+}
+
+func (t *statsTarget) read(dingocli *cli.DingoCli) (map[string]float64, error) {
+	if t.reader != nil {
+		return t.reader()
+	} else if t.isLocal() {
+		return readStats(t.mountPoint)
+	}
+	return t.readRemote(dingocli)
+}
+
+// readTargets reads all targets concurrently and waits at most wait (0 means waiting
+// for all), a slow target keeps reading in background and its result is picked up
+// by the next round, so it doesn't stall the rows of other targets. The target keeps
+// its last counters if read failed or not finished, so it only shows zero for the interval.
+func readTargets(dingocli *cli.DingoCli, targets []*statsTarget, wait time.Duration) {
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		target.mutex.Lock()
+		if target.reading {
+			target.mutex.Unlock()
+			continue
+		}
+		target.reading = true
+		target.mutex.Unlock()
+
+		wg.Add(1)
+		go func(t *statsTarget) {
+			defer wg.Done()
+			current, err := t.read(dingocli)
+			t.mutex.Lock()
+			t.reading = false
+			t.result, t.resultErr = current, err
+			t.mutex.Unlock()
+		}(target)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	if wait > 0 {
+		select {
+		case <-done:
+		case <-time.After(wait):
+		}
+	} else {
+		<-done
+	}
+
+	for _, target := range targets {
+		target.mutex.Lock()
+		if target.reading {
+			target.err = fmt.Errorf("read stats of %s timeout", target.name)
+		} else if target.result != nil || target.resultErr != nil {
+			target.err = target.resultErr
+			if target.err == nil {
+				target.current = target.result
+			}
+			target.result, target.resultErr = nil, nil
+		}
+		target.mutex.Unlock()
+	}
+}
+
+// sumStats sums the counters of all targets, the aggregated row is calculated
+// from the sum, so latency and hit ratio are weighted by requests.
+func sumStats(targets []*statsTarget, get func(*statsTarget) map[string]float64) map[string]float64 {
+	sum := map[string]float64{}
+	for _, target := range targets {
+		for k, v := range get(target) {
+			sum[k] += v
+		}
+	}
+	return sum
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+/*
+ * TestReadTargetsSlow, run: go test ./cli/command/fs/stats -run ^TestReadTargetsSlow$
+ */
+func TestReadTargetsSlow(t *testing.T) {
+	release := make(chan struct{})
+	fast := &statsTarget{name: "fast", reader: func() (map[string]float64, error) {
+		return map[string]float64{"counter": 1}, nil
+	}}
+	slow := &statsTarget{name: "slow", reader: func() (map[string]float64, error) {
+		<-release
+		return map[string]float64{"counter": 2}, nil
+	}}
+	slow.current = map[string]float64{"counter": 0}
+	targets := []*statsTarget{fast, slow}
+
+	begin := time.Now()
+	readTargets(nil, targets, 100*time.Millisecond)
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Fatalf("readTargets() blocked by slow target for %v", elapsed)
+	}
+	if fast.err != nil || fast.current["counter"] != 1 {
+		t.Errorf("fast target: current = %v, err = %v", fast.current, fast.err)
+	}
+	if slow.err == nil || slow.current["counter"] != 0 {
+		t.Errorf("slow target should keep last counters and be marked failed: current = %v, err = %v",
+			slow.current, slow.err)
+	}
+
+	// the late result is picked up by the next round
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+	readTargets(nil, targets, 100*time.Millisecond)
+	if slow.err != nil || slow.current["counter"] != 2 {
+		t.Errorf("slow target: current = %v, err = %v", slow.current, slow.err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package stats
+
+import (
+	"os"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/mattn/go-isatty"
+)
+
+type (
+	// Item is one column of section, the nick is shown in header and must be size <= 5
+	Item struct {
+		Nick string
+		Name string
+		Type uint8
+	}
+
+	Section struct {
+		Name  string
+		Items []Item
+	}
+
+	// Source reads the counters of one target, e.g. the /vars of mds
+	Source struct {
+		Name string
+		Read func() (map[string]float64, error)
+	}
+
+	WatchOptions struct {
+		Interval time.Duration
+		Count    uint32
+		Format   string
+	}
+)
+
+func CheckFormat(format string) error {
+	return checkStatsFormat(format)
+}
+
+func toSections(sections []Section) []*section {
+	out := []*section{}
+	for _, s := range sections {
+		items := []*item{}
+		for _, it := range s.Items {
+			items = append(items, &item{it.Nick, it.Name, it.Type})
+		}
+		out = append(out, &section{name: s.Name, items: items})
+	}
+	return out
+}
+
+// Watch shows the statistics of sources in the same way as mountpoints
+func Watch(dingocli *cli.DingoCli, sections []Section, sources []Source, options WatchOptions) error {
+	targets := []*statsTarget{}
+	for _, source := range sources {
+		targets = append(targets, &statsTarget{name: source.Name, reader: source.Read})
+	}
+
+	watcher := &statsWatcher{
+		colorful:   isatty.IsTerminal(os.Stdout.Fd()) && options.Format != STATS_FORMAT_CSV,
+		duration:   options.Interval,
+		mountPoint: sources[0].Name,
+		interval:   int64(options.Interval / time.Second),
+		count:      options.Count,
+		format:     options.Format,
+		sections:   toSections(sections),
+	}
+	watcher.formatHeader()
+	return watchTargets(dingocli, watcher, targets, nil)
+}
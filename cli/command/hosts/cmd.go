@@ -18,6 +18,7 @@ package hosts
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/hosts/disk"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -35,6 +36,12 @@ func NewHostsCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewCommitCommand(dingocli),
 		NewShowCommand(dingocli),
 		NewListCommand(dingocli),
+		NewTrustCommand(dingocli),
+		NewFactsCommand(dingocli),
+		NewTuneCommand(dingocli),
+		NewNetperfCommand(dingocli),
+		NewNetperfAgentCommand(dingocli),
+		disk.NewDiskCommand(dingocli),
 	)
 	return cmd
 }
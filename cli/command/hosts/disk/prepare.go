@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package disk
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PREPARE_EXAMPLE = `Examples:
+  $ dingo hosts disk prepare                # Format, mount and persist disks declared in hosts
+  $ dingo hosts disk prepare -l dingofs     # Prepare disks on hosts which have label 'dingofs'
+  $ dingo hosts disk prepare --force        # Format disks even if they have filesystem or partitions
+  $ dingo hosts disk prepare --noconfirm    # Prepare disks without confirmation`
+)
+
+type prepareOptions struct {
+	labels    string
+	force     bool
+	noConfirm bool
+}
+
+func NewPrepareCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options prepareOptions
+
+	cmd := &cobra.Command{
+		Use:     "prepare [OPTIONS]",
+		Short:   "Format and mount data disks",
+		Args:    cliutil.NoArgs,
+		Example: PREPARE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPrepare(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.labels, "labels", "l", "", "Specify the host labels")
+	flags.BoolVar(&options.force, "force", false, "Format disks even if they have filesystem or partitions (data will be lost)")
+	flags.BoolVar(&options.noConfirm, "noconfirm", false, "Prepare disks without confirmation")
+
+	return cmd
+}
+
+func genPreparePlaybook(dingocli *cli.DingoCli,
+	hcs []*hosts.HostConfig,
+	options prepareOptions) (*playbook.Playbook, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.PREPARE_HOST_DISKS,
+		Configs: hcs,
+		Options: map[string]interface{}{
+			comm.KEY_DISK_PREPARE_FORCE: options.force,
+		},
+	})
+	return pb, nil
+}
+
+func runPrepare(dingocli *cli.DingoCli, options prepareOptions) error {
+	// 1) filter hosts which declared disks
+	hcs, err := filterHosts(dingocli, options.labels)
+	if err != nil {
+		return err
+	}
+
+	// 2) generate prepare playbook
+	pb, err := genPreparePlaybook(dingocli, hcs, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) confirm by user
+	if !options.noConfirm {
+		disks := []string{}
+		for _, hc := range hcs {
+			for _, disk := range hc.GetDisks() {
+				disks = append(disks, fmt.Sprintf("%s:%s -> %s", hc.GetHost(), disk.Device, disk.MountPoint))
+			}
+		}
+		if pass := tui.ConfirmYes(tui.PromptPrepareDisks(disks, options.force)); !pass {
+			dingocli.WriteOut(tui.PromptCancelOpetation("prepare disks"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) format, mount and persist disks
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 5) display status and verify data_dir
+	return displayDisksStatus(dingocli, hcs)
+}
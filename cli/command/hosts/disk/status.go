@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package disk
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STATUS_EXAMPLE = `Examples:
+  $ dingo hosts disk status                # Display status of disks declared in hosts
+  $ dingo hosts disk status -l dingofs     # Display status of disks on hosts which have label 'dingofs'`
+)
+
+type statusOptions struct {
+	labels string
+}
+
+func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options statusOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [OPTIONS]",
+		Short:   "Display status of data disks",
+		Args:    cliutil.NoArgs,
+		Example: STATUS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.labels, "labels", "l", "", "Specify the host labels")
+
+	return cmd
+}
+
+// filterHosts returns the hosts which declared disks
+func filterHosts(dingocli *cli.DingoCli, labels string) ([]*hosts.HostConfig, error) {
+	data := dingocli.Hosts()
+	if len(data) == 0 {
+		return nil, errno.ERR_EMPTY_HOSTS
+	}
+	hcs, err := hosts.Filter(data, strings.Split(labels, ":"))
+	if err != nil {
+		return nil, err
+	}
+
+	out := []*hosts.HostConfig{}
+	for _, hc := range hcs {
+		if len(hc.GetDisks()) > 0 {
+			out = append(out, hc)
+		}
+	}
+	if len(out) == 0 {
+		return nil, errno.ERR_NO_DISKS_DECLARED
+	}
+	return out, nil
+}
+
+func isUnder(dir, mountPoint string) bool {
+	dir, mountPoint = path.Clean(dir), path.Clean(mountPoint)
+	return dir == mountPoint || strings.HasPrefix(dir, mountPoint+"/")
+}
+
+/*
+ * verifyDataDirs finds the disk which data_dir of each service in
+ * current cluster is on, and warns the data_dir which not on any disk
+ * or the check skipped for the topology can't be parsed.
+ */
+func verifyDataDirs(dingocli *cli.DingoCli, hcs []*hosts.HostConfig) (map[string][]string, []string) {
+	usedBy := map[string][]string{}
+	warnings := []string{}
+	if dingocli.ClusterId() == -1 {
+		return usedBy, warnings
+	}
+	dcs, err := dingocli.ParseTopology()
+	if err != nil { // the disks status is still useful, so we only warn it
+		warnings = append(warnings, color.YellowString(
+			"data_dir check skipped, parse topology of cluster %s failed: %s", dingocli.ClusterName(), err))
+		return usedBy, warnings
+	}
+
+	disks := map[string][]hosts.Disk{}
+	for _, hc := range hcs {
+		disks[hc.GetHost()] = hc.GetDisks()
+	}
+	for _, dc := range dcs {
+		dataDir := dc.GetDataDir()
+		candidates, ok := disks[dc.GetHost()]
+		if !ok || len(dataDir) == 0 {
+			continue
+		}
+
+		var matched *hosts.Disk
+		for i, disk := range candidates {
+			if isUnder(dataDir, disk.MountPoint) &&
+				(matched == nil || len(disk.MountPoint) > len(matched.MountPoint)) {
+				matched = &candidates[i]
+			}
+		}
+		serviceId := dingocli.GetServiceId(dc.GetId())
+		if matched == nil {
+			warnings = append(warnings, color.YellowString(
+				"data_dir %s of %s service %s on host %s is not on any declared disk",
+				dataDir, dc.GetRole(), serviceId, dc.GetHost()))
+			continue
+		}
+		key := dc.GetHost() + ":" + matched.Device
+		usedBy[key] = append(usedBy[key], dc.GetRole()+"/"+serviceId)
+	}
+	return usedBy, warnings
+}
+
+func getDisksStatus(dingocli *cli.DingoCli, hcs []*hosts.HostConfig) ([]task.DiskStatus, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.GET_HOST_DISKS_STATUS,
+		Configs: hcs,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+		},
+	})
+	if err := pb.Run(); err != nil {
+		return nil, err
+	}
+
+	statuses := []task.DiskStatus{}
+	value := dingocli.MemStorage().Get(comm.KEY_ALL_DISK_STATUS)
+	if value != nil {
+		for _, status := range value.(map[string]task.DiskStatus) {
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Host != statuses[j].Host {
+			return statuses[i].Host < statuses[j].Host
+		}
+		return statuses[i].Device < statuses[j].Device
+	})
+	return statuses, nil
+}
+
+func displayDisksStatus(dingocli *cli.DingoCli, hcs []*hosts.HostConfig) error {
+	statuses, err := getDisksStatus(dingocli, hcs)
+	if err != nil {
+		return err
+	}
+
+	usedBy, warnings := verifyDataDirs(dingocli, hcs)
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatDiskStatus(statuses, usedBy))
+	for _, warning := range warnings {
+		dingocli.WriteOutln(warning)
+	}
+	return nil
+}
+
+func runStatus(dingocli *cli.DingoCli, options statusOptions) error {
+	hcs, err := filterHosts(dingocli, options.labels)
+	if err != nil {
+		return err
+	}
+	return displayDisksStatus(dingocli, hcs)
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package hosts
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FACTS_EXAMPLE = `Examples:
+  $ dingo hosts facts                  # Collect facts of all hosts and cache them
+  $ dingo hosts facts -l dingofs       # Collect facts of hosts which have label 'dingofs'
+  $ dingo hosts facts --cached -v      # Display cached facts with block devices and NICs
+  $ dingo hosts facts --format json    # Display facts in JSON format`
+
+	FACTS_FORMAT_TABLE = "table"
+	FACTS_FORMAT_JSON  = "json"
+)
+
+type factsOptions struct {
+	labels  string
+	cached  bool
+	format  string
+	verbose bool
+}
+
+func NewFactsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options factsOptions
+
+	cmd := &cobra.Command{
+		Use:     "facts [OPTIONS]",
+		Short:   "Collect and display hardware and software facts of hosts",
+		Args:    cliutil.NoArgs,
+		Example: FACTS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFacts(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.labels, "labels", "l", "", "Specify the host labels")
+	flags.BoolVar(&options.cached, "cached", false, "Display cached facts instead of collecting them")
+	flags.StringVar(&options.format, "format", FACTS_FORMAT_TABLE, "Output format (table/json)")
+	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Verbose output for facts")
+
+	return cmd
+}
+
+func collectFacts(dingocli *cli.DingoCli, hcs []*hosts.HostConfig) ([]task.HostFacts, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.COLLECT_HOST_FACTS,
+		Configs: hcs,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+			SkipError:    true, // hosts which we can't connect are reported later
+		},
+	})
+	if err := pb.Run(); err != nil {
+		return nil, err
+	}
+
+	m := map[string]task.HostFacts{}
+	value := dingocli.MemStorage().Get(comm.KEY_ALL_HOST_FACTS)
+	if value != nil {
+		m = value.(map[string]task.HostFacts)
+	}
+	facts := []task.HostFacts{}
+	for _, hc := range hcs {
+		f, ok := m[hc.GetHost()]
+		if !ok {
+			continue
+		}
+		if err := task.SaveHostFacts(dingocli.Storage(), f); err != nil {
+			return nil, errno.ERR_REPLACE_HOST_FACTS_FAILED.E(err)
+		}
+		facts = append(facts, f)
+	}
+	return facts, nil
+}
+
+func loadFacts(dingocli *cli.DingoCli, hcs []*hosts.HostConfig) ([]task.HostFacts, error) {
+	facts := []task.HostFacts{}
+	for _, hc := range hcs {
+		f, err := task.LoadHostFacts(dingocli.Storage(), hc.GetHost())
+		if err != nil {
+			return nil, errno.ERR_SELECT_HOST_FACTS_FAILED.E(err)
+		} else if f != nil {
+			facts = append(facts, *f)
+		}
+	}
+	return facts, nil
+}
+
+func runFacts(dingocli *cli.DingoCli, options factsOptions) error {
+	if options.format != FACTS_FORMAT_TABLE && options.format != FACTS_FORMAT_JSON {
+		return errno.ERR_UNSUPPORT_FACTS_FORMAT.F("format: %s", options.format)
+	}
+
+	// 1) filter hosts
+	data := dingocli.Hosts()
+	if len(data) == 0 {
+		return errno.ERR_EMPTY_HOSTS
+	}
+	hcs, err := hosts.Filter(data, strings.Split(options.labels, ":"))
+	if err != nil {
+		return err
+	}
+
+	// 2) collect facts or load them from database
+	var facts []task.HostFacts
+	if options.cached {
+		facts, err = loadFacts(dingocli, hcs)
+	} else {
+		facts, err = collectFacts(dingocli, hcs)
+	}
+	if err != nil {
+		return err
+	}
+
+	// 3) display facts
+	if options.format == FACTS_FORMAT_JSON {
+		bytes, err := json.MarshalIndent(facts, "", "  ")
+		if err != nil {
+			return errno.ERR_ENCODE_INFO_TO_JSON_FAILED.E(err)
+		}
+		dingocli.WriteOutln("%s", string(bytes))
+	} else {
+		dingocli.WriteOutln("")
+		dingocli.WriteOut("%s", tui.FormatHostFacts(facts, options.verbose))
+	}
+
+	if len(facts) < len(hcs) {
+		missing := []string{}
+		got := map[string]bool{}
+		for _, f := range facts {
+			got[f.Host] = true
+		}
+		for _, hc := range hcs {
+			if !got[hc.GetHost()] {
+				missing = append(missing, hc.GetHost())
+			}
+		}
+		if options.cached {
+			return errno.ERR_HOST_FACTS_NOT_CACHED.F("hosts: %s", strings.Join(missing, ","))
+		}
+		dingocli.WriteOutln(color.YellowString("Collect facts failed on hosts: %s", strings.Join(missing, ",")))
+		return errno.ERR_SSH_CONNECT_FAILED.F("hosts: %s", strings.Join(missing, ","))
+	}
+	return nil
+}
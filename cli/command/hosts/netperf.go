@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package hosts
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/netperf"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	NETPERF_EXAMPLE = `Examples:
+  $ dingo hosts netperf                          # Test network between all service hosts
+  $ dingo hosts netperf --role store             # Test network between hosts which run store
+  $ dingo hosts netperf --min-throughput 10000   # Warn if the throughput of link below 10Gbps`
+
+	DEFAULT_NETPERF_PORT           = 9566
+	DEFAULT_NETPERF_PINGS          = 20
+	DEFAULT_NETPERF_DURATION       = 3 * time.Second
+	DEFAULT_NETPERF_MAX_LATENCY    = 1.0    // ms
+	DEFAULT_NETPERF_MIN_THROUGHPUT = 1000.0 // Mbps
+)
+
+var (
+	NETPERF_PLAYBOOK_STEPS = []int{
+		playbook.START_NETPERF_SERVER,
+		playbook.RUN_NETPERF_CLIENT,
+	}
+)
+
+type (
+	netperfOptions struct {
+		host          string
+		role          string
+		port          int
+		pings         int
+		duration      time.Duration
+		maxLatency    float64
+		minThroughput float64
+	}
+
+	netperfAgentOptions struct {
+		listen   string
+		timeout  time.Duration
+		connect  string
+		pings    int
+		duration time.Duration
+	}
+)
+
+func NewNetperfCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options netperfOptions
+
+	cmd := &cobra.Command{
+		Use:     "netperf [OPTIONS]",
+		Short:   "Test latency and throughput between service hosts",
+		Args:    cliutil.NoArgs,
+		Example: NETPERF_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNetperf(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.IntVar(&options.port, "port", DEFAULT_NETPERF_PORT, "Specify the port of netperf server")
+	flags.IntVar(&options.pings, "pings", DEFAULT_NETPERF_PINGS, "Number of round trips to measure latency")
+	flags.DurationVar(&options.duration, "duration", DEFAULT_NETPERF_DURATION, "Duration to measure throughput of each link")
+	flags.Float64Var(&options.maxLatency, "max-latency", DEFAULT_NETPERF_MAX_LATENCY, "Warn if the average latency (ms) of link above it")
+	flags.Float64Var(&options.minThroughput, "min-throughput", DEFAULT_NETPERF_MIN_THROUGHPUT, "Warn if the throughput (Mbps) of link below it")
+
+	return cmd
+}
+
+// NewNetperfAgentCommand is executed on hosts by 'dingo hosts netperf'
+func NewNetperfAgentCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options netperfAgentOptions
+
+	cmd := &cobra.Command{
+		Use:    "netperf-agent [OPTIONS]",
+		Short:  "Run netperf server or client",
+		Args:   cliutil.NoArgs,
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNetperfAgent(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.listen, "listen", "", "Run as server and listen on address")
+	flags.DurationVar(&options.timeout, "timeout", 10*time.Minute, "The server exits after timeout")
+	flags.StringVar(&options.connect, "connect", "", "Run as client and connect to address")
+	flags.IntVar(&options.pings, "pings", DEFAULT_NETPERF_PINGS, "Number of round trips to measure latency")
+	flags.DurationVar(&options.duration, "duration", DEFAULT_NETPERF_DURATION, "Duration to measure throughput")
+
+	return cmd
+}
+
+func runNetperfAgent(dingocli *cli.DingoCli, options netperfAgentOptions) error {
+	if len(options.listen) > 0 {
+		server, err := netperf.NewServer(options.listen)
+		if err != nil {
+			return errno.ERR_START_NETPERF_SERVER_FAILED.E(err)
+		}
+		return server.Serve(options.timeout)
+	}
+
+	if err := netperf.CheckOptions(options.pings, options.duration); err != nil {
+		return errno.ERR_INVALID_NETPERF_OPTION.E(err)
+	}
+	result := netperf.Run(options.connect, options.pings, options.duration)
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errno.ERR_ENCODE_INFO_TO_JSON_FAILED.E(err)
+	}
+	dingocli.WriteOutln("%s", string(data))
+	return nil
+}
+
+// one deploy config per host, the listen ip of first service is used as target
+func getNetperfHosts(dingocli *cli.DingoCli, options netperfOptions) ([]*topology.DeployConfig, map[string]string, error) {
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return nil, nil, err
+	}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   "*",
+		Role: options.role,
+		Host: options.host,
+	})
+
+	selected := []*topology.DeployConfig{}
+	targets := map[string]string{}
+	for _, dc := range dcs {
+		if _, ok := targets[dc.GetHost()]; ok || dc.GetRole() == topology.ROLE_FS_MDS_CLI {
+			continue
+		}
+		targets[dc.GetHost()] = dc.GetListenIp()
+		selected = append(selected, dc)
+	}
+	if len(selected) < 2 {
+		return nil, nil, errno.ERR_NO_SERVICES_MATCHED.S("netperf requires at least 2 hosts")
+	}
+	return selected, targets, nil
+}
+
+func genNetperfPlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	options task.NetperfOptions) (*playbook.Playbook, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range NETPERF_PLAYBOOK_STEPS {
+		execOptions := playbook.ExecOptions{
+			SkipError: true, // test the links between the remaining hosts
+		}
+		if step == playbook.RUN_NETPERF_CLIENT {
+			execOptions.Concurrency = 1 // only one link is tested at the same time
+		}
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: dcs,
+			Options: map[string]interface{}{
+				comm.KEY_NETPERF_OPTIONS: options,
+			},
+			ExecOptions: execOptions,
+		})
+	}
+	pb.AddPostStep(&playbook.PlaybookStep{
+		Type:    playbook.STOP_NETPERF_SERVER,
+		Configs: dcs,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+			SkipError:    true,
+		},
+	})
+	return pb, nil
+}
+
+func displayNetperfResults(dingocli *cli.DingoCli, targets map[string]string, options netperfOptions) {
+	results := map[string]map[string]netperf.Result{}
+	if v := dingocli.MemStorage().Get(comm.KEY_ALL_NETPERF_RESULTS); v != nil {
+		results = v.(map[string]map[string]netperf.Result)
+	}
+	hosts := []string{}
+	for host := range targets {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatNetperfMatrix(hosts, results, options.maxLatency, options.minThroughput))
+
+	warnings := []string{}
+	for _, from := range hosts {
+		for _, to := range hosts {
+			r, ok := results[from][to]
+			if from == to {
+				continue
+			} else if !ok {
+				warnings = append(warnings, fmt.Sprintf("%s -> %s: not tested", from, to))
+			} else if len(r.Error) > 0 {
+				warnings = append(warnings, fmt.Sprintf("%s -> %s: %s", from, to, r.Error))
+			} else if tui.IsSlowLink(r, options.maxLatency, options.minThroughput) {
+				warnings = append(warnings, fmt.Sprintf("%s -> %s: latency=%.2fms throughput=%.0fMbps",
+					from, to, r.LatencyAvg, r.Throughput))
+			}
+		}
+	}
+	if len(warnings) > 0 {
+		dingocli.WriteOutln("")
+		dingocli.WriteOutln(color.YellowString("%d links failed or below thresholds (latency<=%.2fms, throughput>=%.0fMbps):",
+			len(warnings), options.maxLatency, options.minThroughput))
+		for _, warning := range warnings {
+			dingocli.WriteOutln(color.YellowString("  %s", warning))
+		}
+	}
+}
+
+func runNetperf(dingocli *cli.DingoCli, options netperfOptions) error {
+	// 1) the local binary is uploaded to hosts as netperf agent
+	if err := netperf.CheckOptions(options.pings, options.duration); err != nil {
+		return errno.ERR_INVALID_NETPERF_OPTION.F("pings: %d, duration: %s", options.pings, options.duration)
+	} else if runtime.GOOS != "linux" {
+		return errno.ERR_NETPERF_UNSUPPORTED_PLATFORM.F("os: %s", runtime.GOOS)
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		return errno.ERR_NETPERF_UNSUPPORTED_PLATFORM.E(err)
+	}
+
+	// 2) select one service per host
+	dcs, targets, err := getNetperfHosts(dingocli, options)
+	if err != nil {
+		return err
+	}
+
+	// 3) start servers, run clients and stop servers
+	n := time.Duration(len(targets))
+	pb, err := genNetperfPlaybook(dingocli, dcs, task.NetperfOptions{
+		Binary:   binary,
+		Dir:      task.NewNetperfDir(),
+		Port:     options.port,
+		Pings:    options.pings,
+		Duration: options.duration,
+		Timeout:  n*n*(options.duration+10*time.Second) + 5*time.Minute,
+		Targets:  targets,
+	})
+	if err != nil {
+		return err
+	} else if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 4) display the matrix and warn the slow links
+	displayNetperfResults(dingocli, targets, options)
+	return nil
+}
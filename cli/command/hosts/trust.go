@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package hosts
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	TRUST_EXAMPLE = `Examples:
+  $ dingo hosts trust                # Record host keys of all hosts into ~/.ssh/known_hosts
+  $ dingo hosts trust -l dingofs     # Record host keys of hosts which have label 'dingofs'
+
+The unknown host key is confirmed here if ssh_host_key_policy is "ask",
+the deploy tasks never ask for it but fail with unknown host key.`
+
+	HOST_KEY_FAILED = "failed"
+)
+
+type trustOptions struct {
+	labels string
+}
+
+func NewTrustCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options trustOptions
+
+	cmd := &cobra.Command{
+		Use:     "trust [OPTIONS]",
+		Short:   "Scan and record SSH host keys of hosts",
+		Args:    cliutil.NoArgs,
+		Example: TRUST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrust(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.labels, "labels", "l", "", "Specify the host labels")
+
+	return cmd
+}
+
+func runTrust(dingocli *cli.DingoCli, options trustOptions) error {
+	data := dingocli.Hosts()
+	if len(data) == 0 {
+		return errno.ERR_EMPTY_HOSTS
+	}
+	hcs, err := hosts.Filter(data, strings.Split(options.labels, ":"))
+	if err != nil {
+		return err
+	}
+
+	keys := []tui.HostKey{}
+	mismatch := []string{}
+	failed := []string{}
+	for _, hc := range hcs {
+		config := hc.GetSSHConfig()
+		scans, err := module.ScanHostKeys(*config)
+		for _, scan := range scans {
+			keys = append(keys, tui.HostKey{
+				Host:        hc.GetHost(),
+				Address:     scan.Address,
+				KeyType:     scan.Key.Type(),
+				Fingerprint: ssh.FingerprintSHA256(scan.Key),
+				Status:      scan.Status,
+			})
+			if scan.Status == module.HOST_KEY_MISMATCH {
+				mismatch = append(mismatch, scan.Address)
+			}
+		}
+
+		if err != nil && !errors.Is(err, module.ErrHostKeyMismatch) && !errors.Is(err, module.ErrHostKeyRejected) {
+			log.Error("Scan SSH host key",
+				log.Field("host", hc.GetHost()),
+				log.Field("error", err))
+			keys = append(keys, tui.HostKey{
+				Host:    hc.GetHost(),
+				Address: fmt.Sprintf("%s:%d", config.Host, config.Port),
+				Status:  HOST_KEY_FAILED,
+			})
+			failed = append(failed, hc.GetHost())
+		}
+	}
+
+	dingocli.WriteOut(tui.FormatHostKeys(keys))
+	if len(mismatch) > 0 {
+		return errno.ERR_SSH_HOST_KEY_MISMATCH.
+			F("%s: remove the stale key by 'ssh-keygen -R' if the host is reinstalled",
+				strings.Join(mismatch, ","))
+	} else if len(failed) > 0 {
+		return errno.ERR_SSH_CONNECT_FAILED.
+			F("hosts: %s", strings.Join(failed, ","))
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package hosts
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	TUNE_EXAMPLE = `Examples:
+  $ dingo hosts tune                   # Apply tuning profiles on all hosts of cluster
+  $ dingo hosts tune --role store      # Apply tuning profiles on hosts which run store
+  $ dingo hosts tune --check           # Audit the tuning of hosts without changing anything
+  $ dingo hosts tune --revert          # Restore the original values saved before first tuning`
+)
+
+type tuneOptions struct {
+	host   string
+	role   string
+	check  bool
+	revert bool
+}
+
+func NewTuneCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options tuneOptions
+
+	cmd := &cobra.Command{
+		Use:     "tune [OPTIONS]",
+		Short:   "Tune sysctl, ulimit, sysfs, fuse and irqbalance of hosts by service roles",
+		Args:    cliutil.NoArgs,
+		Example: TUNE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTune(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.host, "host", "*", "Specify service host")
+	flags.StringVar(&options.role, "role", "*", "Specify service role")
+	flags.BoolVar(&options.check, "check", false, "Only audit the tuning, exit with error if any item drifts")
+	flags.BoolVar(&options.revert, "revert", false, "Revert the tuning with the saved snapshot")
+
+	return cmd
+}
+
+/*
+ * the profile of host is merged from all roles which deployed on it,
+ * so --role only selects hosts instead of narrowing the profile.
+ */
+func getTuneHosts(dingocli *cli.DingoCli, options tuneOptions) ([]*topology.DeployConfig, map[string][]string, error) {
+	dcs, err := dingocli.ParseTopology()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := map[string][]string{}
+	for _, dc := range dcs {
+		role := dc.GetRole()
+		if role == topology.ROLE_FS_MDS_CLI || cliutil.Contains(roles[dc.GetHost()], role) {
+			continue
+		}
+		roles[dc.GetHost()] = append(roles[dc.GetHost()], role)
+	}
+
+	selected := []*topology.DeployConfig{}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   "*",
+		Role: options.role,
+		Host: options.host,
+	})
+	for _, dc := range dcs {
+		if dc.GetRole() != topology.ROLE_FS_MDS_CLI {
+			sort.Strings(roles[dc.GetHost()])
+			selected = append(selected, dc)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+	return selected, roles, nil
+}
+
+func loadTuneSnapshots(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, revert bool) (map[string]task.TuneSnapshot, error) {
+	snapshots := map[string]task.TuneSnapshot{}
+	for _, dc := range dcs {
+		host := dc.GetHost()
+		if _, ok := snapshots[host]; ok {
+			continue
+		}
+		snapshot, err := task.LoadTuneSnapshot(dingocli.Storage(), host)
+		if err != nil {
+			return nil, errno.ERR_SELECT_TUNE_SNAPSHOT_FAILED.E(err)
+		} else if snapshot != nil {
+			snapshots[host] = *snapshot
+		} else if revert {
+			return nil, errno.ERR_TUNE_SNAPSHOT_NOT_FOUND.F("host: %s", host)
+		}
+	}
+	return snapshots, nil
+}
+
+func genTunePlaybook(dingocli *cli.DingoCli,
+	dcs []*topology.DeployConfig,
+	mode string,
+	roles map[string][]string,
+	snapshots map[string]task.TuneSnapshot) (*playbook.Playbook, error) {
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.TUNE_HOST,
+		Configs: dcs,
+		Options: map[string]interface{}{
+			comm.KEY_TUNE_MODE:       mode,
+			comm.KEY_TUNE_HOST_ROLES: roles,
+			comm.KEY_TUNE_SNAPSHOTS:  snapshots,
+		},
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar: true,
+			SkipError:    true, // hosts which we can't connect are reported later
+		},
+	})
+	return pb, nil
+}
+
+// saveTuneSnapshots persists the snapshots after apply or removes them after revert
+func saveTuneSnapshots(dingocli *cli.DingoCli, mode string, results map[string][]task.TuneResult) error {
+	if mode == task.TUNE_MODE_CHECK {
+		return nil
+	}
+
+	snapshots := dingocli.MemStorage().Get(comm.KEY_TUNE_SNAPSHOTS).(map[string]task.TuneSnapshot)
+	for host := range results {
+		if mode == task.TUNE_MODE_APPLY {
+			if err := task.SaveTuneSnapshot(dingocli.Storage(), snapshots[host]); err != nil {
+				return errno.ERR_REPLACE_TUNE_SNAPSHOT_FAILED.E(err)
+			}
+			continue
+		}
+
+		// keep the snapshot for retrying if revert failed partly
+		failed := false
+		for _, r := range results[host] {
+			failed = failed || r.Action == task.TUNE_ACTION_FAILED
+		}
+		if !failed {
+			if err := dingocli.Storage().DeleteTuneSnapshot(host); err != nil {
+				return errno.ERR_DELETE_TUNE_SNAPSHOT_FAILED.E(err)
+			}
+		}
+	}
+	return nil
+}
+
+func runTune(dingocli *cli.DingoCli, options tuneOptions) error {
+	mode := task.TUNE_MODE_APPLY
+	if options.check && options.revert {
+		return errno.ERR_CONFLICT_TUNE_MODE
+	} else if options.check {
+		mode = task.TUNE_MODE_CHECK
+	} else if options.revert {
+		mode = task.TUNE_MODE_REVERT
+	}
+
+	// 1) get hosts and their roles from topology
+	dcs, roles, err := getTuneHosts(dingocli, options)
+	if err != nil {
+		return err
+	}
+	snapshots, err := loadTuneSnapshots(dingocli, dcs, options.revert)
+	if err != nil {
+		return err
+	}
+
+	// 2) check, apply or revert tuning on hosts
+	pb, err := genTunePlaybook(dingocli, dcs, mode, roles, snapshots)
+	if err != nil {
+		return err
+	} else if err := pb.Run(); err != nil {
+		return err
+	}
+	results := map[string][]task.TuneResult{}
+	if v := dingocli.MemStorage().Get(comm.KEY_ALL_TUNE_RESULTS); v != nil {
+		results = v.(map[string][]task.TuneResult)
+	}
+	if err := saveTuneSnapshots(dingocli, mode, results); err != nil {
+		return err
+	}
+
+	// 3) display what changed
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatTuneResults(results))
+
+	missing := []string{}
+	for _, dc := range dcs {
+		if _, ok := results[dc.GetHost()]; !ok && !cliutil.Contains(missing, dc.GetHost()) {
+			missing = append(missing, dc.GetHost())
+		}
+	}
+	if len(missing) > 0 {
+		dingocli.WriteOutln(color.YellowString("Tune failed on hosts: %s", strings.Join(missing, ",")))
+		return errno.ERR_TUNE_HOST_FAILED.F("hosts: %s", strings.Join(missing, ","))
+	}
+
+	drift, failed := 0, 0
+	for _, rs := range results {
+		for _, r := range rs {
+			switch r.Action {
+			case task.TUNE_ACTION_DRIFT:
+				drift++
+			case task.TUNE_ACTION_FAILED:
+				failed++
+			}
+		}
+	}
+	if failed > 0 {
+		return errno.ERR_TUNE_HOST_FAILED.F("%d items failed, see the log for details", failed)
+	} else if drift > 0 {
+		return errno.ERR_HOST_TUNING_DRIFT.F("%d items drift", drift)
+	}
+	return nil
+}
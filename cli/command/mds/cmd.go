@@ -34,6 +34,7 @@ func NewMDSCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewStatusCommand(dingocli),
 		NewMdsStartCommand(dingocli),
 		NewMdsMetaCommand(dingocli),
+		NewMdsStatsCommand(dingocli),
 	)
 
 	return cmd
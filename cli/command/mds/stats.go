@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mds
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/stats"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STATS_MDS_EXAMPLE = `Examples:
+   $ dingo mds stats
+   $ dingo mds stats 10.0.0.1:7400 10.0.0.2:7400 --schema urw
+   $ dingo mds stats --interval 5s --format csv > mds.csv`
+
+	URL_MDS_VARS       = "http://%s/vars"
+	MDS_VARS_TIMEOUT   = 3 * time.Second
+	MDS_STATS_SCHEMA   = "urw"
+	MDS_STATS_SECTIONS = "u: usage, r: read rpc, w: write rpc"
+)
+
+// rpc methods of mds service, the name is the underscored method name used by brpc
+var mdsMethods = []string{
+	"lookup", "get_attr", "read_dir", "open", "read_slice",
+	"mk_nod", "mk_dir", "set_attr", "unlink", "rm_dir", "rename", "write_slice",
+}
+
+type mdsStatsOptions struct {
+	endpoints []string
+	schema    string
+	interval  time.Duration
+	count     uint32
+	verbose   bool
+	format    string
+}
+
+// mdsVarsReader reads the /vars of mds, the brpc method statistics only have
+// windowed latency, so the cumulative latency is accumulated by the reader
+// to fit the hist items which diff the total latency between two samples.
+type mdsVarsReader struct {
+	endpoint string
+	client   *http.Client
+	counts   map[string]float64
+	latency  map[string]float64
+}
+
+func NewMdsStatsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options mdsStatsOptions
+
+	cmd := &cobra.Command{
+		Use:     "stats [ENDPOINT...] [OPTIONS]",
+		Short:   "show real time performance statistics of mds",
+		Example: STATS_MDS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			utils.ReadCommandConfig(cmd)
+			options.endpoints = args
+
+			options.schema, err = cmd.Flags().GetString("schema")
+			if err != nil {
+				return err
+			}
+			options.interval, err = cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return err
+			}
+			options.count, err = cmd.Flags().GetUint32("count")
+			if err != nil {
+				return err
+			}
+			options.verbose, err = cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+			options.format, err = cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			return runMdsStats(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().DurationP("interval", "i", 1*time.Second, "Interval time for every output")
+	cmd.Flags().String("schema", MDS_STATS_SCHEMA, fmt.Sprintf("Schema string that controls the output sections (%s)", MDS_STATS_SECTIONS))
+	cmd.Flags().Uint32P("count", "c", 0, "Max outout count(0 is unlimited)")
+	cmd.Flags().BoolP("verbose", "v", false, "Show more info")
+	cmd.Flags().String("format", stats.STATS_FORMAT_TABLE, "Output format (table|csv)")
+
+	utils.AddConfigFileFlag(cmd)
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func buildMdsSchema(schema string, verbose bool) ([]stats.Section, error) {
+	sections := []stats.Section{}
+	for _, r := range schema {
+		var s stats.Section
+		add := func(nick, name string, typ uint8) {
+			s.Items = append(s.Items, stats.Item{Nick: nick, Name: name, Type: typ})
+		}
+		switch r {
+		case 'u':
+			s.Name = "usage"
+			add("cpu", "process_cpu_usage", stats.MetricCPU|stats.MetricCounter)
+			add("mem", "process_memory_resident", stats.MetricGauge)
+			if verbose {
+				add("virt", "process_memory_virtual", stats.MetricGauge)
+			}
+		case 'r':
+			s.Name = "read rpc"
+			add("ops", "dingofs_mds_all", stats.MetricTime|stats.MetricHist)
+			add("lkup", "dingofs_mds_lookup", stats.MetricTime|stats.MetricHist)
+			add("gattr", "dingofs_mds_get_attr", stats.MetricTime|stats.MetricHist)
+			add("rdir", "dingofs_mds_read_dir", stats.MetricTime|stats.MetricHist)
+			if verbose {
+				add("open", "dingofs_mds_open", stats.MetricTime|stats.MetricHist)
+				add("rdslc", "dingofs_mds_read_slice", stats.MetricTime|stats.MetricHist)
+			}
+		case 'w':
+			s.Name = "write rpc"
+			add("mknod", "dingofs_mds_mk_nod", stats.MetricTime|stats.MetricHist)
+			add("mkdir", "dingofs_mds_mk_dir", stats.MetricTime|stats.MetricHist)
+			add("sattr", "dingofs_mds_set_attr", stats.MetricTime|stats.MetricHist)
+			add("unlnk", "dingofs_mds_unlink", stats.MetricTime|stats.MetricHist)
+			if verbose {
+				add("rmdir", "dingofs_mds_rm_dir", stats.MetricTime|stats.MetricHist)
+				add("renam", "dingofs_mds_rename", stats.MetricTime|stats.MetricHist)
+				add("wrslc", "dingofs_mds_write_slice", stats.MetricTime|stats.MetricHist)
+			}
+		default:
+			return nil, errno.ERR_INVALID_STATS_SCHEMA.F("no item defined for '%c' in schema %s", r, schema)
+		}
+		sections = append(sections, s)
+	}
+	if len(sections) == 0 {
+		return nil, errno.ERR_INVALID_STATS_SCHEMA.F("no section to watch in schema '%s'", schema)
+	}
+	return sections, nil
+}
+
+func newMdsVarsReader(endpoint string) *mdsVarsReader {
+	return &mdsVarsReader{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: MDS_VARS_TIMEOUT},
+		counts:   map[string]float64{},
+		latency:  map[string]float64{},
+	}
+}
+
+func (r *mdsVarsReader) fetch() (map[string]float64, error) {
+	url := fmt.Sprintf(URL_MDS_VARS, r.endpoint)
+	resp, err := r.client.Get(url)
+	if err != nil {
+		return nil, errno.ERR_READ_MDS_METRIC_FAILED.E(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errno.ERR_READ_MDS_METRIC_FAILED.F("request %s failed, response (code: %d, msg: %s)",
+			url, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errno.ERR_READ_MDS_METRIC_FAILED.E(err)
+	}
+	return utils.ParseStats(string(data)), nil
+}
+
+// read converts the brpc method statistics (rpc_server_<port>_<service>_<method>_count/latency)
+// to the counters of hist items: <name>_qps_total_count and <name>_lat_total_value
+func (r *mdsVarsReader) read() (map[string]float64, error) {
+	vars, err := r.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]float64{}
+	latency := map[string]float64{}
+	for key, value := range vars {
+		if !strings.HasPrefix(key, "rpc_server_") {
+			continue
+		}
+		for _, method := range mdsMethods {
+			if strings.HasSuffix(key, "_"+method+"_count") {
+				counts[method] += value
+			} else if strings.HasSuffix(key, "_"+method+"_latency") {
+				latency[method] = value
+			}
+		}
+	}
+
+	var allCount, allLatency float64
+	for _, method := range mdsMethods {
+		count := counts[method]
+		if last, ok := r.counts[method]; ok && count > last {
+			r.latency[method] += latency[method] * (count - last)
+		}
+		r.counts[method] = count
+
+		name := "dingofs_mds_" + method
+		vars[name+"_qps_total_count"] = count
+		vars[name+"_lat_total_value"] = r.latency[method]
+		allCount += count
+		allLatency += r.latency[method]
+	}
+	vars["dingofs_mds_all_qps_total_count"] = allCount
+	vars["dingofs_mds_all_lat_total_value"] = allLatency
+	return vars, nil
+}
+
+func getMdsEndpoints(cmd *cobra.Command) ([]string, error) {
+	mdses, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := []string{}
+	for _, mdsInfo := range mdses {
+		if !mdsInfo.GetIsOnline() {
+			continue
+		}
+		location := mdsInfo.GetLocation()
+		endpoints = append(endpoints, fmt.Sprintf("%s:%d", location.GetHost(), location.GetPort()))
+	}
+	if len(endpoints) == 0 {
+		return nil, errno.ERR_READ_MDS_METRIC_FAILED.S("no online mds in cluster")
+	}
+	return endpoints, nil
+}
+
+func runMdsStats(cmd *cobra.Command, dingocli *cli.DingoCli, options mdsStatsOptions) error {
+	var err error
+	if err = stats.CheckFormat(options.format); err != nil {
+		return err
+	} else if options.interval < time.Second {
+		return errno.ERR_INVALID_REFRESH_INTERVAL.F("interval: %s", options.interval)
+	}
+	sections, err := buildMdsSchema(options.schema, options.verbose)
+	if err != nil {
+		return err
+	}
+
+	endpoints := options.endpoints
+	if len(endpoints) == 0 {
+		if endpoints, err = getMdsEndpoints(cmd); err != nil {
+			return err
+		}
+	}
+	sources := []stats.Source{}
+	for _, endpoint := range endpoints {
+		reader := newMdsVarsReader(endpoint)
+		sources = append(sources, stats.Source{Name: endpoint, Read: reader.read})
+	}
+
+	return stats.Watch(dingocli, sections, sources, stats.WatchOptions{
+		Interval: options.interval,
+		Count:    options.count,
+		Format:   options.format,
+	})
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mds
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+/*
+ * TestMdsVarsReader, run: go test ./cli/command/mds -run ^TestMdsVarsReader$
+ */
+func TestMdsVarsReader(t *testing.T) {
+	// every response is the /vars of mds at one moment
+	responses := []string{
+		`process_cpu_usage : 0.5
+rpc_server_7400_dingofs_mds_service_lookup_count : 100
+rpc_server_7400_dingofs_mds_service_lookup_latency : 20
+rpc_server_7400_dingofs_mds_service_mk_dir_count : 10
+rpc_server_7400_dingofs_mds_service_mk_dir_latency : 500`,
+		`process_cpu_usage : 0.6
+rpc_server_7400_dingofs_mds_service_lookup_count : 150
+rpc_server_7400_dingofs_mds_service_lookup_latency : 40
+rpc_server_7400_dingofs_mds_service_mk_dir_count : 10
+rpc_server_7400_dingofs_mds_service_mk_dir_latency : 800`,
+		`rpc_server_7400_dingofs_mds_service_lookup_count : 160
+rpc_server_7400_dingofs_mds_service_lookup_latency : 10
+rpc_server_7400_dingofs_mds_service_mk_dir_count : 12
+rpc_server_7400_dingofs_mds_service_mk_dir_latency : 100`,
+	}
+	round := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vars" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, responses[round])
+	}))
+	defer server.Close()
+
+	expects := []map[string]float64{
+		{ // the first read has no cumulative latency
+			"process_cpu_usage":                      0.5,
+			"dingofs_mds_lookup_qps_total_count":     100,
+			"dingofs_mds_lookup_lat_total_value":     0,
+			"dingofs_mds_mk_dir_qps_total_count":     10,
+			"dingofs_mds_mk_dir_lat_total_value":     0,
+			"dingofs_mds_all_qps_total_count":        110,
+			"dingofs_mds_all_lat_total_value":        0,
+			"dingofs_mds_read_slice_qps_total_count": 0,
+		},
+		{ // 50 lookups with 40us latency, no mkdir
+			"process_cpu_usage":                  0.6,
+			"dingofs_mds_lookup_qps_total_count": 150,
+			"dingofs_mds_lookup_lat_total_value": 2000,
+			"dingofs_mds_mk_dir_qps_total_count": 10,
+			"dingofs_mds_mk_dir_lat_total_value": 0,
+			"dingofs_mds_all_qps_total_count":    160,
+			"dingofs_mds_all_lat_total_value":    2000,
+		},
+		{ // 10 lookups with 10us latency, 2 mkdirs with 100us latency
+			"dingofs_mds_lookup_qps_total_count": 160,
+			"dingofs_mds_lookup_lat_total_value": 2100,
+			"dingofs_mds_mk_dir_qps_total_count": 12,
+			"dingofs_mds_mk_dir_lat_total_value": 200,
+			"dingofs_mds_all_qps_total_count":    172,
+			"dingofs_mds_all_lat_total_value":    2300,
+		},
+	}
+
+	reader := newMdsVarsReader(strings.TrimPrefix(server.URL, "http://"))
+	for i, expect := range expects {
+		round = i
+		vars, err := reader.read()
+		if err != nil {
+			t.Fatalf("round %d: read() error: %v", i, err)
+		}
+		for name, value := range expect {
+			if vars[name] != value {
+				t.Errorf("round %d: %s = %v, expect %v", i, name, vars[name], value)
+			}
+		}
+	}
+}
+
+/*
+ * TestMdsVarsReaderFailed, run: go test ./cli/command/mds -run ^TestMdsVarsReaderFailed$
+ */
+func TestMdsVarsReaderFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	reader := newMdsVarsReader(strings.TrimPrefix(server.URL, "http://"))
+	if _, err := reader.read(); err == nil {
+		t.Errorf("read() expect error for status code 500")
+	}
+}
+
+/*
+ * TestBuildMdsSchema, run: go test ./cli/command/mds -run ^TestBuildMdsSchema$
+ */
+func TestBuildMdsSchema(t *testing.T) {
+	tests := []struct {
+		schema   string
+		sections int
+		err      bool
+	}{
+		{"urw", 3, false},
+		{"r", 1, false},
+		{"urx", 0, true},
+		{"urc", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			sections, err := buildMdsSchema(tt.schema, false)
+			if (err != nil) != tt.err {
+				t.Fatalf("buildMdsSchema(%q) error = %v, expect error %v", tt.schema, err, tt.err)
+			}
+			if !tt.err && len(sections) != tt.sections {
+				t.Errorf("buildMdsSchema(%q) got %d sections, expect %d", tt.schema, len(sections), tt.sections)
+			}
+		})
+	}
+}
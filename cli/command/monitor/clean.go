@@ -17,6 +17,8 @@
 package monitor
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	comm "github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/configure"
@@ -47,11 +49,12 @@ var (
 )
 
 type cleanOptions struct {
-	id    string
-	role  string
-	host  string
-	only  []string
-	force bool
+	id      string
+	role    string
+	host    string
+	only    []string
+	force   bool
+	timeout time.Duration
 }
 
 func NewCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -74,6 +77,7 @@ func NewCleanCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
 	flags.StringSliceVarP(&options.only, "only", "o", CLEAN_ITEMS, "Specify clean item")
 	flags.BoolVarP(&options.force, "force", "f", false, "Force to clean without confirmation")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 	return cmd
 }
 
@@ -102,6 +106,9 @@ func genCleanPlaybook(dingocli *cli.DingoCli,
 			Options: map[string]interface{}{
 				comm.KEY_CLEAN_ITEMS: options.only,
 			},
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
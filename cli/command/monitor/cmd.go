@@ -19,6 +19,9 @@ package monitor
 import (
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/cli/command/monitor/config"
+	"github.com/dingodb/dingocli/cli/command/monitor/dashboards"
+	"github.com/dingodb/dingocli/cli/command/monitor/rules"
+	"github.com/dingodb/dingocli/cli/command/monitor/targets"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -42,6 +45,9 @@ func NewMonitorCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewReloadCommand(dingocli),
 		NewUpgradeCommand(dingocli),
 		config.NewConfigCommand(dingocli),
+		rules.NewRulesCommand(dingocli),
+		dashboards.NewDashboardsCommand(dingocli),
+		targets.NewTargetsCommand(dingocli),
 	)
 	return cmd
 }
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewDashboardsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dashboards",
+		Short: "Manage grafana dashboards",
+		Args:  cliutil.NoArgs,
+		RunE:  cliutil.ShowHelp(dingocli.Err()),
+	}
+
+	cmd.AddCommand(
+		NewListCommand(dingocli),
+		NewSyncCommand(dingocli),
+		NewExportCommand(dingocli),
+	)
+	return cmd
+}
+
+func parseGrafanaConfigs(dingocli *cli.DingoCli) ([]*configure.MonitorConfig, error) {
+	mcs, err := configure.ParseMonitor(dingocli)
+	if err != nil {
+		return nil, err
+	}
+
+	mcs = configure.FilterMonitorConfig(dingocli, mcs, configure.FilterMonitorOption{
+		Id:   "*",
+		Role: configure.ROLE_GRAFANA,
+		Host: "*",
+	})
+	if len(mcs) == 0 {
+		return nil, errno.ERR_NO_SERVICES_MATCHED
+	}
+	return mcs, nil
+}
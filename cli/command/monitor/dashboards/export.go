@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/playbook"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	EXPORT_EXAMPLE = `Examples:
+  $ dingo monitor dashboards export                  # Export dashboards edited in grafana UI into ./dashboards
+  $ dingo monitor dashboards export -o /path/to/dir  # Export dashboards into the specified directory`
+)
+
+type exportOptions struct {
+	dir string
+}
+
+func NewExportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options exportOptions
+	cmd := &cobra.Command{
+		Use:     "export [OPTIONS]",
+		Short:   "Export dashboards from grafana",
+		Args:    cliutil.NoArgs,
+		Example: EXPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&options.dir, "output-dir", "o", "dashboards", "Specify the directory to save dashboards")
+	return cmd
+}
+
+func runExport(dingocli *cli.DingoCli, options exportOptions) error {
+	// 1) parse grafana configure
+	mcs, err := parseGrafanaConfigs(dingocli)
+	if err != nil {
+		return err
+	}
+
+	// 2) generate export playbook
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.EXPORT_GRAFANA_DASHBOARD,
+		Configs: mcs[:1],
+		Options: map[string]interface{}{
+			comm.KEY_DASHBOARD_EXPORT_DIR: options.dir,
+		},
+	})
+
+	// 3) run playground
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 4) display exported dashboards
+	exported := map[string]string{}
+	if v := dingocli.MemStorage().Get(comm.KEY_EXPORTED_DASHBOARDS); v != nil {
+		exported = v.(map[string]string)
+	}
+	uids := []string{}
+	for uid := range exported {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	for _, uid := range uids {
+		dingocli.WriteOutln("%s -> %s", uid, exported[uid])
+	}
+	dingocli.WriteOutln("Exported %d dashboards, set 'dashboard.dir: %s' of grafana in monitor.yaml to keep them on next sync",
+		len(uids), options.dir)
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/task/task/monitor"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List dashboards which will be synced into grafana",
+		Args:    cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(dingocli)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runList(dingocli *cli.DingoCli) error {
+	// 1) parse grafana configure
+	mcs, err := parseGrafanaConfigs(dingocli)
+	if err != nil {
+		return err
+	}
+
+	// 2) load packaged and user dashboards
+	dashboards, err := monitor.LoadMonitorDashboards(mcs[0])
+	if err != nil {
+		return err
+	}
+
+	// 3) display dashboards
+	dingocli.WriteOut("%s", tui.FormatDashboards(dashboards))
+	return nil
+}
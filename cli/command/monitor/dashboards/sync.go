@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package dashboards
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/playbook"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SYNC_EXAMPLE = `Examples:
+  $ dingo monitor dashboards sync   # Push packaged dashboards and dashboards under 'dashboard.dir' into grafana`
+)
+
+func NewSyncCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sync",
+		Short:   "Sync dashboards into grafana",
+		Args:    cliutil.NoArgs,
+		Example: SYNC_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(dingocli)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runSync(dingocli *cli.DingoCli) error {
+	// 1) parse grafana configure
+	mcs, err := parseGrafanaConfigs(dingocli)
+	if err != nil {
+		return err
+	}
+
+	// 2) generate sync playbook
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    playbook.SYNC_GRAFANA_DASHBOARD,
+		Configs: mcs,
+	})
+
+	// 3) run playground
+	if err := pb.Run(); err != nil {
+		return err
+	}
+
+	// 4) print success prompt
+	dingocli.WriteOutln(color.GreenString("Sync grafana dashboards success ^_^"))
+	return nil
+}
@@ -17,6 +17,8 @@
 package monitor
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -50,17 +52,20 @@ var (
 type deployOptions struct {
 	filename      string
 	useLocalImage bool
+	timeout       time.Duration
 }
 
 /*
  * Deploy Steps:
- *   1) pull images(dingofs, node_exporter, prometheus, grafana)
+ *   1) pull images(dingofs, node_exporter, prometheus, grafana, alertmanager, loki, promtail)
  *   2) create container
  *   3) sync config
  *   4) start container
  *     4.1) start node_exporter container
  *     4.2) start prometheus container
  *     4.3) start grafana container
+ *     4.4) start alertmanager container (optional)
+ *     4.5) start loki and promtail container (optional)
  */
 func NewDeployCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options deployOptions
@@ -79,6 +84,7 @@ func NewDeployCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVarP(&options.filename, "conf", "c", "monitor.yaml", "Specify monitor configuration file")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 	return cmd
 }
 
@@ -103,6 +109,7 @@ func genDeployPlaybook(dingocli *cli.DingoCli,
 				ExecOptions: tasks.ExecOptions{
 					SilentMainBar: true,
 					SilentSubBar:  true,
+					Timeout:       options.timeout,
 				},
 			})
 			continue
@@ -110,6 +117,9 @@ func genDeployPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: mcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
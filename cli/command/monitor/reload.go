@@ -17,6 +17,8 @@
 package monitor
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -37,9 +39,10 @@ var (
 )
 
 type reloadOptions struct {
-	id   string
-	role string
-	host string
+	id      string
+	role    string
+	host    string
+	timeout time.Duration
 }
 
 func NewReloadCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -58,6 +61,7 @@ func NewReloadCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify monitor service id")
 	flags.StringVar(&options.role, "role", "*", "Specify monitor service role")
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -84,6 +88,7 @@ func genReloadPlaybook(dingocli *cli.DingoCli,
 				ExecOptions: tasks.ExecOptions{
 					SilentMainBar: true,
 					SilentSubBar:  true,
+					Timeout:       options.timeout,
 				},
 			})
 			continue
@@ -91,6 +96,9 @@ func genReloadPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: mcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
@@ -17,6 +17,8 @@
 package monitor
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -33,9 +35,10 @@ var (
 )
 
 type restartOptions struct {
-	id   string
-	role string
-	host string
+	id      string
+	role    string
+	host    string
+	timeout time.Duration
 }
 
 func NewRestartCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -54,6 +57,7 @@ func NewRestartCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.id, "id", "*", "Specify monitor service id")
 	flags.StringVar(&options.role, "role", "*", "Specify monitor service role")
 	flags.StringVar(&options.host, "host", "*", "Specify monitor service host")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -76,6 +80,9 @@ func genRestartPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: mcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
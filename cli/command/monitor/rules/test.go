@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package rules
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/scripts"
+	"github.com/dingodb/dingocli/internal/tui"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	TEST_EXAMPLE = `Examples:
+  $ dingo monitor rules test                   # Validate the default alert rules shipped with dingo
+  $ dingo monitor rules test a.yml b.yml       # Validate the specified prometheus rule files`
+
+	DEFAULT_RULES_FILE = "<default>/dingofs.yml"
+)
+
+func NewTestCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "test [FILE...]",
+		Short:   "Validate prometheus alert rules offline",
+		Example: TEST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTest(dingocli, args)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func readRules(file string) ([]byte, error) {
+	if file == DEFAULT_RULES_FILE {
+		return []byte(scripts.DINGOFS_ALERT_RULES), nil
+	}
+	data, err := utils.ReadFile(file)
+	if err != nil {
+		return nil, errno.ERR_READ_ALERT_RULES_FAILED.E(err)
+	}
+	return []byte(data), nil
+}
+
+func testRules(file string) ([]configure.AlertRuleIssue, int, error) {
+	data, err := readRules(file)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rules, err := configure.ParseAlertRules(data)
+	if err != nil {
+		return []configure.AlertRuleIssue{{Issue: err.Error()}}, 0, nil
+	}
+	count := 0
+	for _, group := range rules.Groups {
+		count += len(group.Rules)
+	}
+	return configure.CheckAlertRules(rules), count, nil
+}
+
+func runTest(dingocli *cli.DingoCli, files []string) error {
+	if len(files) == 0 {
+		files = []string{DEFAULT_RULES_FILE}
+	}
+
+	// 1) check every rule file
+	total := 0
+	all := map[string][]configure.AlertRuleIssue{}
+	for _, file := range files {
+		issues, count, err := testRules(file)
+		if err != nil {
+			return err
+		}
+		if len(issues) > 0 {
+			all[file] = issues
+			total += len(issues)
+			dingocli.WriteOutln("%s: %s", file, color.RedString("FAILED (%d issues)", len(issues)))
+		} else {
+			dingocli.WriteOutln("%s: %s", file, color.GreenString("SUCCESS (%d rules)", count))
+		}
+	}
+
+	// 2) display issues
+	if total == 0 {
+		return nil
+	}
+	dingocli.WriteOutln("")
+	dingocli.WriteOut("%s", tui.FormatAlertRuleIssues(all))
+	return errno.ERR_INVALID_ALERT_RULES.F("%d issues found", total)
+}
@@ -101,15 +101,15 @@ func displayStatus(dingocli *cli.DingoCli, mcs []*configure.MonitorConfig, optio
 			statuses = append(statuses, status)
 		}
 	}
-	// flite grafana role monitor config
-	var grafanaAddr string
+	// flite grafana and alertmanager role monitor config
+	var grafanaAddr, alertmanagerAddr string
 	for _, mc := range mcs {
-		if mc.GetRole() == configure.ROLE_GRAFANA {
-			grafanaHost := mc.GetHost()
-			grafanaIP := mc.GetContext().Lookup(grafanaHost)
-			grafanaPort := mc.GetListenPort()
-			grafanaAddr = fmt.Sprintf("http://%s:%d", grafanaIP, grafanaPort)
-			break
+		addr := fmt.Sprintf("http://%s:%d", mc.GetContext().Lookup(mc.GetHost()), mc.GetListenPort())
+		switch mc.GetRole() {
+		case configure.ROLE_GRAFANA:
+			grafanaAddr = addr
+		case configure.ROLE_ALERTMANAGER:
+			alertmanagerAddr = addr
 		}
 	}
 
@@ -118,6 +118,9 @@ func displayStatus(dingocli *cli.DingoCli, mcs []*configure.MonitorConfig, optio
 	dingocli.WriteOutln("cluster name    : %s", dingocli.ClusterName())
 	dingocli.WriteOutln("cluster kind    : %s", mcs[0].GetKind())
 	dingocli.WriteOutln("grafana address : %s", grafanaAddr)
+	if len(alertmanagerAddr) > 0 {
+		dingocli.WriteOutln("alert address   : %s", alertmanagerAddr)
+	}
 	dingocli.WriteOutln("")
 	dingocli.WriteOut("%s", output)
 }
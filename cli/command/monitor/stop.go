@@ -17,6 +17,8 @@
 package monitor
 
 import (
+	"time"
+
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure"
 	"github.com/dingodb/dingocli/internal/errno"
@@ -33,10 +35,11 @@ var (
 )
 
 type stopOptions struct {
-	id    string
-	role  string
-	host  string
-	force bool
+	id      string
+	role    string
+	host    string
+	force   bool
+	timeout time.Duration
 }
 
 func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -56,6 +59,7 @@ func NewStopCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.role, "role", "*", "Specify service role")
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Force to stop without confirmation")
+	flags.DurationVar(&options.timeout, "timeout", 0, "Specify the timeout for each step (e.g. 10m), 0 means no limit")
 
 	return cmd
 }
@@ -78,6 +82,9 @@ func genStopPlaybook(dingocli *cli.DingoCli,
 		pb.AddStep(&playbook.PlaybookStep{
 			Type:    step,
 			Configs: mcs,
+			ExecOptions: playbook.ExecOptions{
+				Timeout: options.timeout,
+			},
 		})
 	}
 	return pb, nil
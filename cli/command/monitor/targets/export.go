@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package targets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	EXPORT_EXAMPLE = `Examples:
+  $ dingo monitor targets export                                        # Print file_sd targets of current cluster
+  $ dingo monitor targets export --format yaml -o dingo.yml             # Save targets as YAML
+  $ dingo monitor targets export -o /etc/prometheus/dingo.json --watch  # Re-export targets once topology changed`
+
+	DEFAULT_NODE_EXPORTER_PORT = 9100
+)
+
+type exportOptions struct {
+	format           string
+	output           string
+	nodeExporterPort int
+	watch            bool
+	interval         time.Duration
+}
+
+func NewExportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options exportOptions
+	cmd := &cobra.Command{
+		Use:     "export [OPTIONS]",
+		Short:   "Export prometheus file_sd targets of services and node exporters",
+		Args:    cliutil.NoArgs,
+		Example: EXPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.format, "format", configure.TARGETS_FORMAT_FILE_SD, "Specify output format (file_sd|yaml)")
+	flags.StringVarP(&options.output, "output", "o", "", "Specify output file, print to stdout if not set")
+	flags.IntVar(&options.nodeExporterPort, "node-exporter-port", DEFAULT_NODE_EXPORTER_PORT, "Specify node exporter port, 0 means skip node exporters")
+	flags.BoolVarP(&options.watch, "watch", "w", false, "Watch cluster topology and re-export targets once changed")
+	flags.DurationVar(&options.interval, "interval", 30*time.Second, "Specify interval to check cluster topology in watch mode")
+	return cmd
+}
+
+func genTargets(dingocli *cli.DingoCli, options exportOptions, reload bool) (string, error) {
+	data := dingocli.ClusterTopologyData()
+	if reload { // topology may be committed by another process
+		cluster, err := dingocli.Storage().GetClusterByName(dingocli.ClusterName())
+		if err != nil {
+			return "", errno.ERR_GET_CLUSTER_BY_NAME_FAILED.E(err)
+		}
+		data = cluster.Topology
+	}
+
+	dcs, err := dingocli.ParseTopologyData(data)
+	if err != nil {
+		return "", err
+	}
+	return configure.ExportPrometheusTargets(dcs, dingocli.ClusterName(), options.nodeExporterPort, options.format)
+}
+
+// writeTargets replaces the file atomically, prometheus may read it at any time
+func writeTargets(file, content string) error {
+	tmp := filepath.Join(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
+	if err := os.WriteFile(tmp, []byte(content), 0644); err != nil {
+		return errno.ERR_WRITE_TARGETS_FILE_FAILED.E(err)
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		return errno.ERR_WRITE_TARGETS_FILE_FAILED.E(err)
+	}
+	return nil
+}
+
+func output(dingocli *cli.DingoCli, options exportOptions, content string) error {
+	if len(options.output) == 0 {
+		dingocli.WriteOut("%s", content)
+		return nil
+	}
+	if err := writeTargets(options.output, content); err != nil {
+		return err
+	}
+	dingocli.WriteOutln("[%s] Targets exported to %s", time.Now().Format("2006-01-02 15:04:05"), options.output)
+	return nil
+}
+
+func runExport(dingocli *cli.DingoCli, options exportOptions) error {
+	if dingocli.ClusterId() == -1 {
+		return errno.ERR_NO_CLUSTER_SPECIFIED
+	} else if options.watch && options.interval <= 0 {
+		return errno.ERR_INVALID_REFRESH_INTERVAL.F("interval: %s", options.interval)
+	}
+
+	// 1) export targets of current topology
+	content, err := genTargets(dingocli, options, false)
+	if err != nil {
+		return err
+	}
+	if err := output(dingocli, options, content); err != nil || !options.watch {
+		return err
+	}
+
+	// 2) re-export once topology changed until interrupted
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return watchTargets(ctx, options.interval, content,
+		func() (string, error) { return genTargets(dingocli, options, true) },
+		func(content string) error { return output(dingocli, options, content) },
+		func(err error) {
+			log.Warn("Export targets", log.Field("Error", err))
+			fmt.Fprintln(dingocli.Err(), color.YellowString("[%s] Export targets failed, retry in %s: %s",
+				time.Now().Format("2006-01-02 15:04:05"), options.interval, err))
+		})
+}
+
+// watchTargets re-generates targets every interval and outputs them once changed,
+// errors are transient (e.g. storage locked, file system full) and retried in next tick,
+// the watch only stops when ctx is done.
+func watchTargets(ctx context.Context, interval time.Duration, content string,
+	gen func() (string, error), output func(string) error, warn func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		latest, err := gen()
+		if err != nil {
+			warn(err)
+			continue
+		} else if latest == content {
+			continue
+		}
+		if err := output(latest); err != nil {
+			warn(err) // keep the last content, so output again in next tick
+			continue
+		}
+		content = latest
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package targets
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+/*
+ * TestWatchTargets, run: go test ./cli/command/monitor/targets -run ^TestWatchTargets$
+ */
+func TestWatchTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		gens    []string // "error" means gen failed
+		fails   int      // the first n outputs failed
+		outputs []string
+		warns   int
+	}{
+		{"unchanged", []string{"a", "a", "a"}, 0, []string{}, 0},
+		{"changed", []string{"a", "b", "b", "c"}, 0, []string{"b", "c"}, 0},
+		{"gen failed", []string{"error", "b", "error", "b"}, 0, []string{"b"}, 2},
+		{"output failed", []string{"b", "b", "b"}, 1, []string{"b", "b"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			round := 0
+			gen := func() (string, error) {
+				if round == len(tt.gens) { // ticked again before the canceled context is selected
+					return tt.gens[round-1], nil
+				} else if round == len(tt.gens)-1 {
+					cancel() // stop after the last round
+				}
+				content := tt.gens[round]
+				round++
+				if content == "error" {
+					return "", errors.New("gen failed")
+				}
+				return content, nil
+			}
+			outputs := []string{}
+			output := func(content string) error {
+				outputs = append(outputs, content)
+				if len(outputs) <= tt.fails {
+					return errors.New("output failed")
+				}
+				return nil
+			}
+			warns := 0
+			warn := func(err error) { warns++ }
+
+			done := make(chan error)
+			go func() { done <- watchTargets(ctx, time.Millisecond, "a", gen, output, warn) }()
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Fatalf("watchTargets() error: %v", err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("watchTargets() not stopped after context canceled")
+			}
+
+			if round != len(tt.gens) {
+				t.Errorf("watchTargets() generated %d rounds, expect %d", round, len(tt.gens))
+			}
+			if !reflect.DeepEqual(outputs, tt.outputs) {
+				t.Errorf("watchTargets() outputs = %v, expect %v", outputs, tt.outputs)
+			}
+			if warns != tt.warns {
+				t.Errorf("watchTargets() warns = %d, expect %d", warns, tt.warns)
+			}
+		})
+	}
+}
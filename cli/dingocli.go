@@ -23,6 +23,7 @@ import (
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/cli/command"
+	"github.com/dingodb/dingocli/pkg/module"
 )
 
 func Execute() {
@@ -35,6 +36,7 @@ func Execute() {
 	id := dingocli.PreAudit(time.Now(), os.Args[1:])
 	cmd := command.NewDingoCliCommand(dingocli)
 	err = cmd.Execute()
+	module.GlobalSSHPool().Close()
 	dingocli.PostAudit(id, err)
 	if err != nil {
 		os.Exit(1)
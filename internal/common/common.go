@@ -55,7 +55,20 @@ const (
 	KEY_CHECK_WITH_WEAK          = "CHECK_WITH_WEAK"
 	KEY_CHECK_KERNEL_MODULE_NAME = "CHECK_KERNEL_MODULE_NAME"
 	KEY_CHECK_SKIP_SNAPSHOECLONE = "CHECK_SKIP_SNAPSHOTCLONE"
+	KEY_CHECK_SELECTED_CHECKERS  = "CHECK_SELECTED_CHECKERS"
+	KEY_CHECK_CLAIMED_CHECKERS   = "CHECK_CLAIMED_CHECKERS"
+	KEY_ALL_CHECK_RESULTS        = "ALL_CHECK_RESULTS"
 	KEY_ALL_HOST_DATE            = "ALL_HOST_DATE"
+	KEY_ALL_HOST_KERNEL          = "ALL_HOST_KERNEL"
+	KEY_ALL_HOST_FACTS           = "ALL_HOST_FACTS"
+	KEY_ALL_DISK_STATUS          = "ALL_DISK_STATUS"
+	KEY_DISK_PREPARE_FORCE       = "DISK_PREPARE_FORCE"
+	KEY_TUNE_MODE                = "TUNE_MODE"
+	KEY_TUNE_HOST_ROLES          = "TUNE_HOST_ROLES"
+	KEY_TUNE_SNAPSHOTS           = "TUNE_SNAPSHOTS"
+	KEY_ALL_TUNE_RESULTS         = "ALL_TUNE_RESULTS"
+	KEY_NETPERF_OPTIONS          = "NETPERF_OPTIONS"
+	KEY_ALL_NETPERF_RESULTS      = "ALL_NETPERF_RESULTS"
 
 	// scale-out / migrate
 	KEY_SCALE_OUT_CLUSTER = "SCALE_OUT_CLUSTER"
@@ -64,6 +77,7 @@ const (
 
 	// status
 	KEY_ALL_SERVICE_STATUS = "ALL_SERVICE_STATUS"
+	KEY_ALL_SERVICE_TOP    = "ALL_SERVICE_TOP"
 	SERVICE_STATUS_CLEANED = "Cleaned"
 	SERVICE_STATUS_LOSED   = "Losed"
 	SERVICE_STATUS_UNKNOWN = "Unknown"
@@ -107,6 +121,12 @@ const (
 	KEY_SUPPORT_UPLOAD_URL_FORMAT = "SUPPORT_UPLOAD_URL"
 	KEY_SECRET                    = "SECRET"
 	KEY_ALL_CLIENT_IDS            = "ALL_CLIENT_IDS"
+	KEY_SUPPORT_BUNDLE            = "SUPPORT_BUNDLE"
+
+	// core
+	KEY_ALL_CORE_FILES  = "ALL_CORE_FILES"
+	KEY_FETCH_CORE_FILE = "FETCH_CORE_FILE"
+	KEY_FETCH_CORE_DIR  = "FETCH_CORE_DIR"
 
 	// target
 	KEY_TARGET_OPTIONS = "TARGET_OPTIONS"
@@ -122,6 +142,10 @@ const (
 	KEY_MONITOR_STATUS   = "MONITOR_STATUS"
 	CLEANED_MONITOR_CONF = "-"
 
+	// grafana dashboards
+	KEY_DASHBOARD_EXPORT_DIR = "DASHBOARD_EXPORT_DIR"
+	KEY_EXPORTED_DASHBOARDS  = "EXPORTED_DASHBOARDS"
+
 	// gateway
 	GATEWAY_NAME         = "GATEWAY_NAME"
 	GATEWAY_HOST         = "GATEWAY_HOST"
@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package configure
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"text/template"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ALERT_SEVERITY_CRITICAL = "critical"
+	ALERT_SEVERITY_WARNING  = "warning"
+	ALERT_SEVERITY_INFO     = "info"
+
+	// prometheus template variables which are defined before rendering annotations
+	ALERT_TEMPLATE_PREFIX = "{{$labels := .Labels}}{{$externalLabels := .ExternalLabels}}" +
+		"{{$externalURL := .ExternalURL}}{{$value := .Value}}"
+)
+
+var (
+	REGEX_PROMETHEUS_DURATION = regexp.MustCompile(`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`)
+	REGEX_METRIC_NAME         = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	REGEX_LABEL_NAME          = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	ALERT_SEVERITIES = []string{ALERT_SEVERITY_CRITICAL, ALERT_SEVERITY_WARNING, ALERT_SEVERITY_INFO}
+
+	// functions supported by prometheus alert templates, only used for parsing
+	ALERT_TEMPLATE_FUNCS = template.FuncMap{}
+)
+
+type (
+	AlertRule struct {
+		Alert       string            `yaml:"alert"`
+		Record      string            `yaml:"record"`
+		Expr        string            `yaml:"expr"`
+		For         string            `yaml:"for"`
+		Labels      map[string]string `yaml:"labels"`
+		Annotations map[string]string `yaml:"annotations"`
+	}
+
+	AlertRuleGroup struct {
+		Name     string      `yaml:"name"`
+		Interval string      `yaml:"interval"`
+		Rules    []AlertRule `yaml:"rules"`
+	}
+
+	AlertRules struct {
+		Groups []AlertRuleGroup `yaml:"groups"`
+	}
+
+	AlertRuleIssue struct {
+		Group string
+		Rule  string
+		Issue string
+	}
+)
+
+func init() {
+	for _, name := range []string{
+		"args", "externalURL", "first", "graphLink", "humanize", "humanize1024",
+		"humanizeDuration", "humanizePercentage", "humanizeTimestamp", "label",
+		"match", "parseDuration", "pathPrefix", "query", "reReplaceAll", "safeHtml",
+		"sortByLabel", "stripDomain", "stripPort", "strvalue", "tableLink", "title",
+		"toDuration", "toLower", "toTime", "toUpper", "value",
+	} {
+		ALERT_TEMPLATE_FUNCS[name] = func(...interface{}) interface{} { return nil }
+	}
+}
+
+func (r AlertRule) name() string {
+	if len(r.Alert) > 0 {
+		return r.Alert
+	}
+	return r.Record
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ParseAlertRules parses prometheus rule file, unknown fields are rejected
+func ParseAlertRules(data []byte) (*AlertRules, error) {
+	rules := &AlertRules{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(rules); err != nil {
+		return nil, errno.ERR_INVALID_ALERT_RULES.E(err)
+	}
+	return rules, nil
+}
+
+// checkExpr only checks the brackets and quotes of expression are paired,
+// the full PromQL syntax is checked by prometheus when it loads the rules.
+func checkExpr(expr string) error {
+	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	stack := []rune{}
+	var quote rune
+	escaped := false
+	for _, c := range expr {
+		if quote != 0 {
+			if escaped {
+				escaped = false
+			} else if c == '\\' {
+				escaped = true
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			stack = append(stack, c)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[c] {
+				return fmt.Errorf("unexpected '%c'", c)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated quoted string")
+	} else if len(stack) > 0 {
+		return fmt.Errorf("unclosed '%c'", stack[len(stack)-1])
+	}
+	return nil
+}
+
+func checkAlertRule(rule AlertRule) []string {
+	issues := []string{}
+	if len(rule.Alert) > 0 && len(rule.Record) > 0 {
+		issues = append(issues, "only one of alert and record can be set")
+	} else if len(rule.Alert) == 0 && len(rule.Record) == 0 {
+		issues = append(issues, "one of alert and record must be set")
+	} else if len(rule.Record) > 0 && !REGEX_METRIC_NAME.MatchString(rule.Record) {
+		issues = append(issues, fmt.Sprintf("invalid recording rule name '%s'", rule.Record))
+	}
+
+	if len(rule.Expr) == 0 {
+		issues = append(issues, "expr is empty")
+	} else if err := checkExpr(rule.Expr); err != nil {
+		issues = append(issues, fmt.Sprintf("invalid expr: %s", err))
+	}
+
+	if len(rule.For) > 0 {
+		if len(rule.Record) > 0 {
+			issues = append(issues, "for is not allowed in recording rule")
+		} else if !REGEX_PROMETHEUS_DURATION.MatchString(rule.For) {
+			issues = append(issues, fmt.Sprintf("invalid for duration '%s'", rule.For))
+		}
+	}
+
+	for _, name := range sortedKeys(rule.Labels) {
+		value := rule.Labels[name]
+		if !REGEX_LABEL_NAME.MatchString(name) {
+			issues = append(issues, fmt.Sprintf("invalid label name '%s'", name))
+		} else if name == "severity" && !utils.Contains(ALERT_SEVERITIES, value) {
+			issues = append(issues, fmt.Sprintf("invalid severity '%s', requires one of %v", value, ALERT_SEVERITIES))
+		}
+	}
+
+	if len(rule.Annotations) > 0 && len(rule.Record) > 0 {
+		issues = append(issues, "annotations are not allowed in recording rule")
+	}
+	for _, name := range sortedKeys(rule.Annotations) {
+		text := ALERT_TEMPLATE_PREFIX + rule.Annotations[name]
+		_, err := template.New(name).Funcs(ALERT_TEMPLATE_FUNCS).Parse(text)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("invalid template of annotation '%s': %s", name, err))
+		}
+	}
+	return issues
+}
+
+// CheckAlertRules checks the rules offline and returns all issues found
+func CheckAlertRules(rules *AlertRules) []AlertRuleIssue {
+	issues := []AlertRuleIssue{}
+	if len(rules.Groups) == 0 {
+		issues = append(issues, AlertRuleIssue{Issue: "no rule group found"})
+	}
+
+	names := map[string]bool{}
+	for _, group := range rules.Groups {
+		if len(group.Name) == 0 {
+			issues = append(issues, AlertRuleIssue{Issue: "group name is empty"})
+		} else if names[group.Name] {
+			issues = append(issues, AlertRuleIssue{Group: group.Name, Issue: "duplicate group name"})
+		}
+		names[group.Name] = true
+
+		if len(group.Interval) > 0 && !REGEX_PROMETHEUS_DURATION.MatchString(group.Interval) {
+			issues = append(issues, AlertRuleIssue{
+				Group: group.Name,
+				Issue: fmt.Sprintf("invalid interval '%s'", group.Interval),
+			})
+		}
+		if len(group.Rules) == 0 {
+			issues = append(issues, AlertRuleIssue{Group: group.Name, Issue: "no rule found"})
+		}
+		for _, rule := range group.Rules {
+			for _, issue := range checkAlertRule(rule) {
+				issues = append(issues, AlertRuleIssue{Group: group.Name, Rule: rule.name(), Issue: issue})
+			}
+		}
+	}
+	return issues
+}
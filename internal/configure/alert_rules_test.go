@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package configure
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/task/scripts"
+)
+
+/*
+ * TestCheckExpr, run: go test ./internal/configure -run ^TestCheckExpr$
+ */
+func TestCheckExpr(t *testing.T) {
+	tests := []struct {
+		expr   string
+		expect string // substring of error, empty means valid
+	}{
+		{`up{job="mds"} == 0`, ""},
+		{`rate(foo[5m]) > 0.5 and on(instance) (bar{a=~"x|y"} > 1)`, ""},
+		{`foo{path="(}"} > 0`, ""},
+		{`foo{path="a\"}"} > 0`, ""},
+		{`rate(foo[5m] > 0`, "unclosed '('"},
+		{`up{job="mds" == 0`, "unclosed '{'"},
+		{`rate(foo[5m)) > 0`, "unexpected ')'"},
+		{`up) == 0`, "unexpected ')'"},
+		{`up{job="mds} == 0`, "unterminated quoted string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			err := checkExpr(tt.expr)
+			if len(tt.expect) == 0 {
+				if err != nil {
+					t.Fatalf("expect valid, got %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.expect) {
+				t.Fatalf("expect error contains %q, got %v", tt.expect, err)
+			}
+		})
+	}
+}
+
+/*
+ * TestParseAlertRules, run: go test ./internal/configure -run ^TestParseAlertRules$
+ */
+func TestParseAlertRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		success bool
+	}{
+		{"valid", "groups:\n  - name: g\n    rules:\n      - alert: A\n        expr: up == 0\n", true},
+		{"unknown field", "groups:\n  - name: g\n    rules:\n      - alert: A\n        exp: up == 0\n", false},
+		{"bad yaml", "groups: [\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseAlertRules([]byte(tt.data))
+			if tt.success {
+				if err != nil {
+					t.Fatalf("expect success, got %v", err)
+				} else if len(rules.Groups) != 1 || len(rules.Groups[0].Rules) != 1 {
+					t.Fatalf("unexpected rules: %+v", rules)
+				}
+			} else if err == nil {
+				t.Fatalf("expect error, got rules %+v", rules)
+			}
+		})
+	}
+}
+
+/*
+ * TestCheckAlertRules, run: go test ./internal/configure -run ^TestCheckAlertRules$
+ */
+func TestCheckAlertRules(t *testing.T) {
+	valid := AlertRule{
+		Alert:       "MDSOffline",
+		Expr:        `up{job="mds"} == 0`,
+		For:         "1m",
+		Labels:      map[string]string{"severity": ALERT_SEVERITY_CRITICAL},
+		Annotations: map[string]string{"summary": "MDS {{ $labels.instance }} is offline"},
+	}
+	with := func(f func(r *AlertRule)) AlertRule {
+		r := valid
+		f(&r)
+		return r
+	}
+
+	tests := []struct {
+		name   string
+		rules  []AlertRule
+		expect []string // substrings of issues in order
+	}{
+		{"valid", []AlertRule{valid}, nil},
+		{"valid recording rule", []AlertRule{{Record: "job:up:sum", Expr: "sum(up) by (job)"}}, nil},
+		{"missing alert", []AlertRule{with(func(r *AlertRule) { r.Alert = "" })}, []string{"one of alert and record must be set"}},
+		{"missing expr", []AlertRule{with(func(r *AlertRule) { r.Expr = "" })}, []string{"expr is empty"}},
+		{"bad for", []AlertRule{with(func(r *AlertRule) { r.For = "5 minutes" })}, []string{"invalid for duration '5 minutes'"}},
+		{"bad for unit", []AlertRule{with(func(r *AlertRule) { r.For = "1x" })}, []string{"invalid for duration '1x'"}},
+		{"unbalanced expr", []AlertRule{with(func(r *AlertRule) { r.Expr = "rate(foo[5m] > 0" })}, []string{"invalid expr: unclosed '('"}},
+		{"bad severity", []AlertRule{with(func(r *AlertRule) { r.Labels = map[string]string{"severity": "page"} })}, []string{"invalid severity 'page'"}},
+		{
+			"bad annotation",
+			[]AlertRule{with(func(r *AlertRule) { r.Annotations = map[string]string{"summary": "{{ $labels.instance "} })},
+			[]string{"invalid template of annotation 'summary'"},
+		},
+		{
+			"multiple issues",
+			[]AlertRule{with(func(r *AlertRule) { r.Alert = ""; r.Expr = "" })},
+			[]string{"one of alert and record must be set", "expr is empty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := CheckAlertRules(&AlertRules{Groups: []AlertRuleGroup{{Name: "g", Rules: tt.rules}}})
+			if len(issues) != len(tt.expect) {
+				t.Fatalf("expect %d issues, got %+v", len(tt.expect), issues)
+			}
+			for i, issue := range issues {
+				if issue.Group != "g" || !strings.Contains(issue.Issue, tt.expect[i]) {
+					t.Errorf("expect issue %q in group g, got %+v", tt.expect[i], issue)
+				}
+			}
+		})
+	}
+
+	// group level issues
+	issues := CheckAlertRules(&AlertRules{Groups: []AlertRuleGroup{
+		{Name: "g", Interval: "1m", Rules: []AlertRule{valid}},
+		{Name: "g", Interval: "soon"},
+	}})
+	expect := []string{"duplicate group name", "invalid interval 'soon'", "no rule found"}
+	if len(issues) != len(expect) {
+		t.Fatalf("expect %d issues, got %+v", len(expect), issues)
+	}
+	for i, issue := range issues {
+		if !strings.Contains(issue.Issue, expect[i]) {
+			t.Errorf("expect issue %q, got %+v", expect[i], issue)
+		}
+	}
+	if issues := CheckAlertRules(&AlertRules{}); len(issues) != 1 {
+		t.Errorf("expect no rule group issue, got %+v", issues)
+	}
+}
+
+/*
+ * TestDefaultAlertRules, run: go test ./internal/configure -run ^TestDefaultAlertRules$
+ */
+func TestDefaultAlertRules(t *testing.T) {
+	rules, err := ParseAlertRules([]byte(scripts.DINGOFS_ALERT_RULES))
+	if err != nil {
+		t.Fatalf("parse default rules: %v", err)
+	}
+	if issues := CheckAlertRules(rules); len(issues) > 0 {
+		t.Fatalf("default rules have issues: %+v", issues)
+	}
+
+	found := false
+	for _, group := range rules.Groups {
+		for _, rule := range group.Rules {
+			if rule.Alert == "QuotaNearLimit" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Errorf("QuotaNearLimit rule not found in default rules")
+	}
+}
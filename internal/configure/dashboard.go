@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package configure
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/errno"
+)
+
+const (
+	DASHBOARD_ROOT_DIR       = "dashboards"
+	DASHBOARD_COMMON_KIND    = "common"
+	DASHBOARD_SOURCE_BUILTIN = "builtin"
+
+	DEFAULT_DASHBOARD_LANGUAGE = "zh"
+)
+
+// Dashboard is a grafana dashboard model which will be pushed into grafana,
+// the datasource of panels is resolved by the dashboard's own template variable.
+type Dashboard struct {
+	Uid     string
+	Title   string
+	Source  string // builtin or the local file path
+	Content []byte
+}
+
+func ParseDashboard(source string, data []byte) (*Dashboard, error) {
+	model := map[string]interface{}{}
+	if err := json.Unmarshal(data, &model); err != nil {
+		return nil, errno.ERR_INVALID_GRAFANA_DASHBOARD.F("%s: %v", source, err)
+	}
+
+	uid, _ := model["uid"].(string)
+	title, _ := model["title"].(string)
+	if len(uid) == 0 || len(title) == 0 {
+		return nil, errno.ERR_INVALID_GRAFANA_DASHBOARD.F("%s", source)
+	}
+	return &Dashboard{
+		Uid:     uid,
+		Title:   title,
+		Source:  source,
+		Content: data,
+	}, nil
+}
+
+// pick the requested language if packaged, otherwise fallback to the default one
+// and then any other packaged language.
+func chooseDashboardLanguage(builtin fs.FS, dir, language string) string {
+	entries, err := fs.ReadDir(builtin, dir)
+	if err != nil {
+		return ""
+	}
+	languages := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			languages = append(languages, entry.Name())
+		}
+	}
+	sort.Strings(languages)
+	for _, want := range []string{language, DEFAULT_DASHBOARD_LANGUAGE} {
+		for _, lang := range languages {
+			if lang == want {
+				return lang
+			}
+		}
+	}
+	if len(languages) > 0 {
+		return languages[0]
+	}
+	return ""
+}
+
+func loadBuiltinDashboards(builtin fs.FS, kind, language string) ([]*Dashboard, error) {
+	dashboards := []*Dashboard{}
+	for _, k := range []string{DASHBOARD_COMMON_KIND, kind} {
+		kindDir := path.Join(DASHBOARD_ROOT_DIR, k)
+		lang := chooseDashboardLanguage(builtin, kindDir, language)
+		if len(lang) == 0 {
+			continue
+		}
+		files, err := fs.Glob(builtin, path.Join(kindDir, lang, "*.json"))
+		if err != nil {
+			return nil, errno.ERR_READ_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		for _, file := range files {
+			data, err := fs.ReadFile(builtin, file)
+			if err != nil {
+				return nil, errno.ERR_READ_GRAFANA_DASHBOARD_FAILED.E(err)
+			}
+			dashboard, err := ParseDashboard(DASHBOARD_SOURCE_BUILTIN, data)
+			if err != nil {
+				return nil, err
+			}
+			dashboards = append(dashboards, dashboard)
+		}
+	}
+	return dashboards, nil
+}
+
+func loadLocalDashboards(dir string) ([]*Dashboard, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, errno.ERR_READ_GRAFANA_DASHBOARD_FAILED.E(err)
+	}
+	dashboards := []*Dashboard{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errno.ERR_READ_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		dashboard, err := ParseDashboard(file, data)
+		if err != nil {
+			return nil, err
+		}
+		dashboards = append(dashboards, dashboard)
+	}
+	return dashboards, nil
+}
+
+// LoadDashboards returns the dashboards packaged for cluster kind and language,
+// dashboards under the local directory override the packaged ones with the same uid.
+func LoadDashboards(builtin fs.FS, kind, language, dir string) ([]*Dashboard, error) {
+	dashboards, err := loadBuiltinDashboards(builtin, kind, strings.ToLower(language))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dir) > 0 {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, errno.ERR_READ_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		locals, err := loadLocalDashboards(dir)
+		if err != nil {
+			return nil, err
+		}
+		dashboards = append(dashboards, locals...)
+	}
+
+	// the latter one wins
+	index := map[string]int{}
+	ret := []*Dashboard{}
+	for _, dashboard := range dashboards {
+		if i, ok := index[dashboard.Uid]; ok {
+			ret[i] = dashboard
+			continue
+		}
+		index[dashboard.Uid] = len(ret)
+		ret = append(ret, dashboard)
+	}
+	return ret, nil
+}
@@ -35,6 +35,10 @@ const (
 	KEY_AUTO_UPGRADE = "auto_upgrade"
 	KEY_SSH_RETRIES  = "retries"
 	KEY_SSH_TIMEOUT  = "timeout"
+	KEY_SSH_POOL     = "pool"
+	KEY_SSH_SESSIONS = "pool_max_sessions"
+	KEY_SSH_IDLE     = "pool_idle_timeout"
+	KEY_SSH_HOST_KEY = "ssh_host_key_policy"
 	KEY_DB_URL       = "url"
 
 	// rqlite://127.0.0.1:4000
@@ -55,6 +59,10 @@ type (
 		AutoUpgrade bool
 		SSHRetries  int
 		SSHTimeout  int
+		SSHPool     bool
+		SSHSessions int
+		SSHIdle     int
+		SSHHostKey  string
 		DBUrl       string
 	}
 
@@ -74,6 +82,12 @@ var (
 		"warn":  true,
 		"error": true,
 	}
+
+	SUPPORT_SSH_HOST_KEY_POLICY = map[string]bool{
+		"strict":     true,
+		"accept-new": true,
+		"ask":        true,
+	}
 )
 
 func ReplaceGlobals(cfg *DingoCliConfig) {
@@ -90,6 +104,10 @@ func newDefault() *DingoCliConfig {
 		AutoUpgrade: true,
 		SSHRetries:  3,
 		SSHTimeout:  10,
+		SSHPool:     true,
+		SSHSessions: 8,
+		SSHIdle:     300,
+		SSHHostKey:  "accept-new",
 		DBUrl:       fmt.Sprintf("sqlite://%s/.dingo/data/dingocli.db", home),
 	}
 	return cfg
@@ -188,6 +206,38 @@ func parseConnectionSection(cfg *DingoCliConfig, connection map[string]interface
 			}
 			cfg.SSHTimeout = num
 
+		// ssh connection pool
+		case KEY_SSH_POOL:
+			yes, err := requirePositiveBool(KEY_SSH_POOL, v)
+			if err != nil {
+				return err
+			}
+			cfg.SSHPool = yes
+
+		// max sessions shared one pooled connection
+		case KEY_SSH_SESSIONS:
+			num, err := requirePositiveInt(KEY_SSH_SESSIONS, v)
+			if err != nil {
+				return err
+			}
+			cfg.SSHSessions = num
+
+		// idle seconds before pooled connection closed
+		case KEY_SSH_IDLE:
+			num, err := requirePositiveInt(KEY_SSH_IDLE, v)
+			if err != nil {
+				return err
+			}
+			cfg.SSHIdle = num
+
+		// policy for unknown host key
+		case KEY_SSH_HOST_KEY:
+			if !SUPPORT_SSH_HOST_KEY_POLICY[v.(string)] {
+				return errno.ERR_UNSUPPORT_SSH_HOST_KEY_POLICY.
+					F("%s: %s", KEY_SSH_HOST_KEY, v.(string))
+			}
+			cfg.SSHHostKey = v.(string)
+
 		default:
 			return errno.ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM.
 				F("%s: %s", k, v)
@@ -266,12 +316,16 @@ func ParseDingoCliConfig(filename string) (*DingoCliConfig, error) {
 	return cfg, nil
 }
 
-func (cfg *DingoCliConfig) GetLogLevel() string  { return cfg.LogLevel }
-func (cfg *DingoCliConfig) GetTimeout() int      { return cfg.Timeout }
-func (cfg *DingoCliConfig) GetAutoUpgrade() bool { return cfg.AutoUpgrade }
-func (cfg *DingoCliConfig) GetSSHRetries() int   { return cfg.SSHRetries }
-func (cfg *DingoCliConfig) GetSSHTimeout() int   { return cfg.SSHTimeout }
-func (cfg *DingoCliConfig) GetEngine() string    { return cfg.Engine }
+func (cfg *DingoCliConfig) GetLogLevel() string   { return cfg.LogLevel }
+func (cfg *DingoCliConfig) GetTimeout() int       { return cfg.Timeout }
+func (cfg *DingoCliConfig) GetAutoUpgrade() bool  { return cfg.AutoUpgrade }
+func (cfg *DingoCliConfig) GetSSHRetries() int    { return cfg.SSHRetries }
+func (cfg *DingoCliConfig) GetSSHTimeout() int    { return cfg.SSHTimeout }
+func (cfg *DingoCliConfig) GetSSHPool() bool      { return cfg.SSHPool }
+func (cfg *DingoCliConfig) GetSSHSessions() int   { return cfg.SSHSessions }
+func (cfg *DingoCliConfig) GetSSHIdle() int       { return cfg.SSHIdle }
+func (cfg *DingoCliConfig) GetSSHHostKey() string { return cfg.SSHHostKey }
+func (cfg *DingoCliConfig) GetEngine() string     { return cfg.Engine }
 func (cfg *DingoCliConfig) GetSudoAlias() string {
 	if len(cfg.SudoAlias) == 0 {
 		return WITHOUT_SUDO
@@ -17,6 +17,8 @@
 package hosts
 
 import (
+	"fmt"
+
 	comm "github.com/dingodb/dingocli/internal/configure/common"
 	"github.com/dingodb/dingocli/internal/configure/dingocli"
 	"github.com/dingodb/dingocli/internal/utils"
@@ -65,8 +67,19 @@ func (hc *HostConfig) GetSSHHostname() string    { return hc.getString(CONFIG_SS
 func (hc *HostConfig) GetSSHPort() int           { return hc.getInt(CONFIG_SSH_PORT) }
 func (hc *HostConfig) GetPrivateKeyFile() string { return hc.getString(CONFIG_PRIVATE_CONFIG_FILE) }
 func (hc *HostConfig) GetForwardAgent() bool     { return hc.getBool(CONFIG_FORWARD_AGENT) }
+func (hc *HostConfig) GetAuthMethod() string     { return hc.getString(CONFIG_AUTH_METHOD) }
+func (hc *HostConfig) GetPassword() string       { return hc.getString(CONFIG_PASSWORD) }
 func (hc *HostConfig) GetBecomeUser() string     { return hc.getString(CONFIG_BECOME_USER) }
 func (hc *HostConfig) GetEnvs() []string         { return hc.envs }
+func (hc *HostConfig) GetDisks() []Disk          { return hc.disks }
+
+func (hc *HostConfig) GetCertificateFile() string {
+	return hc.getString(CONFIG_CERTIFICATE_FILE)
+}
+
+func (hc *HostConfig) GetPrivateKeyPassphrase() string {
+	return hc.getString(CONFIG_PRIVATE_KEY_PASSPHRASE)
+}
 
 func (hc *HostConfig) GetLabels() []string {
 	if len(hc.labels) == 0 {
@@ -83,12 +96,53 @@ func (hc *HostConfig) GetUser() string {
 	return user
 }
 
+// GetProxyJump returns the jump hosts, the user, port, private key and
+// forward agent of jump host inherit from the host if not specified.
+func (hc *HostConfig) GetProxyJump() []module.SSHConfig {
+	jumps := []module.SSHConfig{}
+	for _, jump := range hc.jumps {
+		config := module.SSHConfig{
+			User:              jump.User,
+			Host:              jump.Host,
+			Port:              (uint)(jump.Port),
+			PrivateKeyPath:    jump.PrivateKeyFile,
+			ForwardAgent:      hc.GetForwardAgent(),
+			ConnectTimeoutSec: dingocli.GlobalDingoCliConfig.GetSSHTimeout(),
+			HostKeyPolicy:     dingocli.GlobalDingoCliConfig.GetSSHHostKey(),
+		}
+		if len(config.User) == 0 {
+			config.User = hc.GetUser()
+		}
+		if config.Port == 0 {
+			config.Port = DEFAULT_SSH_PORT
+		}
+		passphrase := jump.Passphrase
+		if len(config.PrivateKeyPath) == 0 {
+			config.PrivateKeyPath = hc.GetPrivateKeyFile()
+			config.CertificatePath = hc.GetCertificateFile()
+			passphrase = hc.GetPrivateKeyPassphrase()
+		}
+		if jump.ForwardAgent != nil {
+			config.ForwardAgent = *jump.ForwardAgent
+		}
+		if len(jump.CertificateFile) > 0 {
+			config.CertificatePath = jump.CertificateFile
+		}
+		if !config.ForwardAgent {
+			config.Passphrase = secretFunc(passphrase,
+				fmt.Sprintf("Enter passphrase for key '%s':", config.PrivateKeyPath))
+		}
+		jumps = append(jumps, config)
+	}
+	return jumps
+}
+
 func (hc *HostConfig) GetSSHConfig() *module.SSHConfig {
 	hostname := hc.GetSSHHostname()
 	if len(hostname) == 0 {
 		hostname = hc.GetHostname()
 	}
-	return &module.SSHConfig{
+	config := &module.SSHConfig{
 		User:              hc.GetUser(),
 		Host:              hostname,
 		Port:              (uint)(hc.GetSSHPort()),
@@ -99,5 +153,22 @@ func (hc *HostConfig) GetSSHConfig() *module.SSHConfig {
 		BecomeUser:        hc.GetBecomeUser(),
 		ConnectTimeoutSec: dingocli.GlobalDingoCliConfig.GetSSHTimeout(),
 		ConnectRetries:    dingocli.GlobalDingoCliConfig.GetSSHRetries(),
+		HostKeyPolicy:     dingocli.GlobalDingoCliConfig.GetSSHHostKey(),
+		ProxyJump:         hc.GetProxyJump(),
+	}
+	if config.ForwardAgent {
+		return config
+	}
+
+	switch hc.GetAuthMethod() {
+	case AUTH_METHOD_PASSWORD:
+		config.PrivateKeyPath = ""
+		config.Password = secretFunc(hc.GetPassword(),
+			fmt.Sprintf("%s@%s's password:", config.User, config.Host))
+	default:
+		config.CertificatePath = hc.GetCertificateFile()
+		config.Passphrase = secretFunc(hc.GetPrivateKeyPassphrase(),
+			fmt.Sprintf("Enter passphrase for key '%s':", config.PrivateKeyPath))
 	}
+	return config
 }
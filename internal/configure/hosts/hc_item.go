@@ -25,6 +25,9 @@ import (
 
 const (
 	DEFAULT_SSH_PORT = 22
+
+	AUTH_METHOD_PUBLICKEY = "publickey"
+	AUTH_METHOD_PASSWORD  = "password"
 )
 
 var (
@@ -76,6 +79,34 @@ var (
 		},
 	)
 
+	CONFIG_PRIVATE_KEY_PASSPHRASE = itemset.Insert(
+		"private_key_passphrase",
+		comm.REQUIRE_STRING,
+		false,
+		nil,
+	)
+
+	CONFIG_CERTIFICATE_FILE = itemset.Insert(
+		"certificate_file",
+		comm.REQUIRE_STRING,
+		false,
+		nil,
+	)
+
+	CONFIG_AUTH_METHOD = itemset.Insert(
+		"auth_method",
+		comm.REQUIRE_STRING,
+		false,
+		AUTH_METHOD_PUBLICKEY,
+	)
+
+	CONFIG_PASSWORD = itemset.Insert(
+		"password",
+		comm.REQUIRE_STRING,
+		false,
+		nil,
+	)
+
 	CONFIG_FORWARD_AGENT = itemset.Insert(
 		"forward_agent",
 		comm.REQUIRE_BOOL,
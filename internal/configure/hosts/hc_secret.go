@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package hosts
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+/*
+ * secrets (password, private key passphrase) are never stored in hosts,
+ * we only record where to get it:
+ *   prompt          prompt user once for each process
+ *   env:NAME        read from environment variable
+ *   file:/path      read from file, e.g. mounted secret
+ */
+const (
+	SECRET_PROMPT      = "prompt"
+	SECRET_ENV_PREFIX  = "env:"
+	SECRET_FILE_PREFIX = "file:"
+)
+
+var (
+	secretMutex sync.Mutex
+	secretCache = map[string]string{}
+)
+
+func checkSecret(field, source string) error {
+	switch {
+	case source == SECRET_PROMPT:
+		return nil
+	case strings.HasPrefix(source, SECRET_ENV_PREFIX):
+		if len(strings.TrimPrefix(source, SECRET_ENV_PREFIX)) > 0 {
+			return nil
+		}
+	case strings.HasPrefix(source, SECRET_FILE_PREFIX):
+		filename := strings.TrimPrefix(source, SECRET_FILE_PREFIX)
+		if !strings.HasPrefix(filename, "/") {
+			break
+		} else if !utils.PathExist(filename) {
+			return errno.ERR_READ_SSH_SECRET_FAILED.
+				F("%s = %s: no such file", field, source)
+		}
+		return nil
+	}
+	return errno.ERR_INVALID_SSH_SECRET.F("%s = %s", field, source)
+}
+
+// the prompted secret is cached by prompt message, so hosts sharing
+// the same private key or user will only be prompted once
+func resolveSecret(source, message string) (string, error) {
+	secretMutex.Lock()
+	defer secretMutex.Unlock()
+
+	key := source
+	if source == SECRET_PROMPT {
+		key = source + ":" + message
+	}
+	if secret, ok := secretCache[key]; ok {
+		return secret, nil
+	}
+
+	var secret string
+	switch {
+	case source == SECRET_PROMPT:
+		s, err := tui.PromptSecret(message)
+		if err != nil {
+			return "", errno.ERR_READ_SSH_SECRET_FAILED.E(err)
+		}
+		secret = s
+	case strings.HasPrefix(source, SECRET_ENV_PREFIX):
+		name := strings.TrimPrefix(source, SECRET_ENV_PREFIX)
+		s, ok := os.LookupEnv(name)
+		if !ok {
+			return "", errno.ERR_READ_SSH_SECRET_FAILED.
+				F("environment variable %s not set", name)
+		}
+		secret = s
+	case strings.HasPrefix(source, SECRET_FILE_PREFIX):
+		data, err := utils.ReadFile(strings.TrimPrefix(source, SECRET_FILE_PREFIX))
+		if err != nil {
+			return "", errno.ERR_READ_SSH_SECRET_FAILED.E(err)
+		}
+		secret = strings.TrimRight(data, "\r\n")
+	default:
+		return "", errno.ERR_INVALID_SSH_SECRET.F("%s", source)
+	}
+
+	secretCache[key] = secret
+	return secret, nil
+}
+
+func secretFunc(source, message string) module.SecretFunc {
+	if len(source) == 0 {
+		return nil
+	}
+	return func() (string, error) {
+		return resolveSecret(source, message)
+	}
+}
@@ -18,18 +18,32 @@ package hosts
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dingodb/dingocli/internal/build"
 	"github.com/dingodb/dingocli/internal/configure/os"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
-	KEY_LABELS = "labels"
-	KEY_ENVS   = "envs"
+	KEY_LABELS     = "labels"
+	KEY_ENVS       = "envs"
+	KEY_PROXY_JUMP = "proxy_jump"
+	KEY_DISKS      = "disks"
+
+	DISK_FS_EXT4          = "ext4"
+	DISK_FS_XFS           = "xfs"
+	DEFAULT_MOUNT_OPTIONS = "defaults"
+
+	// proxy_jump item: [user@]host[:port]
+	REGEX_PROXY_JUMP = `^(?:([^@\s]+)@)?([^:@\s]+)(?::(\d+))?$`
 
 	PERMISSIONS_600 = 384 // -rw------- (256 + 128 = 384)
 )
@@ -40,11 +54,32 @@ type (
 		Host   []map[string]interface{} `mapstructure:"hosts"`
 	}
 
+	// ProxyJump is a jump host, the empty fields inherit from the host
+	ProxyJump struct {
+		User            string
+		Host            string
+		Port            int
+		PrivateKeyFile  string
+		Passphrase      string
+		CertificateFile string
+		ForwardAgent    *bool
+	}
+
+	// Disk is a data disk which should be formatted and mounted
+	Disk struct {
+		Device     string
+		Filesystem string
+		MountPoint string
+		Options    string // mount options
+	}
+
 	HostConfig struct {
 		sequence int
 		config   map[string]interface{}
 		labels   []string
 		envs     []string
+		jumps    []ProxyJump
+		disks    []Disk
 	}
 )
 
@@ -103,6 +138,296 @@ func (hc *HostConfig) convertEnvs() error {
 	return nil
 }
 
+func (hc *HostConfig) newProxyJump(value interface{}) (ProxyJump, error) {
+	jump := ProxyJump{}
+	address, ok := value.(string)
+	if m, isMap := value.(map[string]interface{}); isMap {
+		address, ok = m["address"].(string)
+		for k, v := range m {
+			switch k {
+			case "address":
+			case CONFIG_PRIVATE_CONFIG_FILE.Key(),
+				CONFIG_PRIVATE_KEY_PASSPHRASE.Key(),
+				CONFIG_CERTIFICATE_FILE.Key():
+				str, isString := v.(string)
+				if !isString || len(str) == 0 {
+					return jump, errno.ERR_CONFIGURE_VALUE_REQUIRES_NON_EMPTY_STRING.
+						F("hosts[%d].%s.%s = %v", hc.sequence, KEY_PROXY_JUMP, k, v)
+				}
+				switch k {
+				case CONFIG_PRIVATE_CONFIG_FILE.Key():
+					jump.PrivateKeyFile = str
+				case CONFIG_PRIVATE_KEY_PASSPHRASE.Key():
+					jump.Passphrase = str
+				default:
+					jump.CertificateFile = str
+				}
+			case CONFIG_FORWARD_AGENT.Key():
+				yes, isBool := v.(bool)
+				if !isBool {
+					return jump, errno.ERR_CONFIGURE_VALUE_REQUIRES_BOOL.
+						F("hosts[%d].%s.%s = %v", hc.sequence, KEY_PROXY_JUMP, k, v)
+				}
+				jump.ForwardAgent = &yes
+			default:
+				return jump, errno.ERR_UNSUPPORT_HOSTS_CONFIGURE_ITEM.
+					F("hosts[%d].%s.%s = %v", hc.sequence, KEY_PROXY_JUMP, k, v)
+			}
+		}
+	}
+
+	mu := regexp.MustCompile(REGEX_PROXY_JUMP).FindStringSubmatch(strings.TrimSpace(address))
+	if !ok || len(mu) == 0 {
+		return jump, errno.ERR_INVALID_PROXY_JUMP.
+			F("hosts[%d].%s = %v", hc.sequence, KEY_PROXY_JUMP, value)
+	}
+	jump.User, jump.Host = mu[1], mu[2]
+	if len(mu[3]) > 0 {
+		jump.Port, _ = utils.Str2Int(mu[3])
+	}
+	if jump.Port > os.GetMaxPortNum() {
+		return jump, errno.ERR_HOSTS_SSH_PORT_EXCEED_MAX_PORT_NUMBER.
+			F("hosts[%d].%s = %v", hc.sequence, KEY_PROXY_JUMP, value)
+	}
+	return jump, nil
+}
+
+/*
+ * proxy_jump supports the following formats:
+ *   proxy_jump: user@bastion:22
+ *   proxy_jump: user@bastion1:22,user@bastion2:22
+ *   proxy_jump:
+ *     - user@bastion1:22
+ *     - address: user@bastion2:22
+ *       private_key_file: /home/dingo/.ssh/bastion_rsa
+ */
+func (hc *HostConfig) convertProxyJump() error {
+	items := []interface{}{}
+	switch value := hc.config[KEY_PROXY_JUMP].(type) {
+	case string:
+		for _, item := range strings.Split(value, ",") {
+			items = append(items, item)
+		}
+	case []interface{}:
+		items = value
+	default:
+		return errno.ERR_INVALID_PROXY_JUMP.
+			F("hosts[%d].%s = %v", hc.sequence, KEY_PROXY_JUMP, value)
+	}
+
+	for _, item := range items {
+		jump, err := hc.newProxyJump(item)
+		if err != nil {
+			return err
+		}
+		hc.jumps = append(hc.jumps, jump)
+	}
+	return nil
+}
+
+func (hc *HostConfig) newDisk(i int, value interface{}) (Disk, error) {
+	field := fmt.Sprintf("hosts[%d].%s[%d]", hc.sequence, KEY_DISKS, i)
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return Disk{}, errno.ERR_INVALID_HOSTS_DISK.F("%s = %v", field, value)
+	}
+
+	disk := Disk{Filesystem: DISK_FS_EXT4, Options: DEFAULT_MOUNT_OPTIONS}
+	for k, v := range m {
+		str, isString := utils.All2Str(v)
+		if !isString || len(str) == 0 {
+			return disk, errno.ERR_CONFIGURE_VALUE_REQUIRES_NON_EMPTY_STRING.
+				F("%s.%s = %v", field, k, v)
+		}
+		switch k {
+		case "device":
+			disk.Device = str
+		case "filesystem":
+			disk.Filesystem = str
+		case "mount_point":
+			disk.MountPoint = str
+		case "options":
+			disk.Options = str
+		default:
+			return disk, errno.ERR_UNSUPPORT_HOSTS_CONFIGURE_ITEM.
+				F("%s.%s = %v", field, k, v)
+		}
+	}
+
+	if !strings.HasPrefix(disk.Device, "/dev/") {
+		return disk, errno.ERR_INVALID_HOSTS_DISK.
+			F("%s.device = %s: requires a device under /dev", field, disk.Device)
+	} else if !strings.HasPrefix(disk.MountPoint, "/") || disk.MountPoint == "/" {
+		return disk, errno.ERR_INVALID_HOSTS_DISK.
+			F("%s.mount_point = %s: requires an absolute path", field, disk.MountPoint)
+	} else if disk.Filesystem != DISK_FS_EXT4 && disk.Filesystem != DISK_FS_XFS {
+		return disk, errno.ERR_INVALID_HOSTS_DISK.
+			F("%s.filesystem = %s: only ext4 and xfs are supported", field, disk.Filesystem)
+	}
+	return disk, nil
+}
+
+/*
+ * disks:
+ *   - device: /dev/sdb
+ *     filesystem: ext4             # default: ext4
+ *     mount_point: /data/disk1
+ *     options: defaults,noatime    # default: defaults
+ */
+func (hc *HostConfig) convertDisks() error {
+	slice, ok := hc.config[KEY_DISKS].([]interface{})
+	if !ok {
+		return errno.ERR_INVALID_HOSTS_DISK.
+			F("hosts[%d].%s = %v", hc.sequence, KEY_DISKS, hc.config[KEY_DISKS])
+	}
+
+	devices, mountPoints := map[string]bool{}, map[string]bool{}
+	for i, value := range slice {
+		disk, err := hc.newDisk(i, value)
+		if err != nil {
+			return err
+		} else if devices[disk.Device] || mountPoints[disk.MountPoint] {
+			return errno.ERR_INVALID_HOSTS_DISK.
+				F("hosts[%d].%s[%d]: duplicate device or mount point", hc.sequence, KEY_DISKS, i)
+		}
+		devices[disk.Device] = true
+		mountPoints[disk.MountPoint] = true
+		hc.disks = append(hc.disks, disk)
+	}
+	return nil
+}
+
+// auth describes how to authenticate with private key
+type auth struct {
+	field           string // field prefix for error message, e.g. hosts[0]
+	privateKeyFile  string
+	passphrase      string
+	certificateFile string
+	forwardAgent    bool
+}
+
+func checkPrivateKeyFile(a auth) error {
+	privateKeyFile := a.privateKeyFile
+	if !strings.HasPrefix(privateKeyFile, "/") {
+		return errno.ERR_PRIVATE_KEY_FILE_REQUIRE_ABSOLUTE_PATH.
+			F("%s.private_key_file = %s", a.field, privateKeyFile)
+	} else if a.forwardAgent {
+		return nil
+	}
+
+	if !utils.PathExist(privateKeyFile) {
+		return errno.ERR_PRIVATE_KEY_FILE_NOT_EXIST.
+			F("%s: no such file", privateKeyFile)
+	} else if utils.GetFilePermissions(privateKeyFile) != PERMISSIONS_600 {
+		return errno.ERR_PRIVATE_KEY_FILE_REQUIRE_600_PERMISSIONS.
+			F("%s: mode (%d)", privateKeyFile, utils.GetFilePermissions(privateKeyFile))
+	}
+
+	// passphrase
+	if len(a.passphrase) > 0 {
+		if err := checkSecret(a.field+".private_key_passphrase", a.passphrase); err != nil {
+			return err
+		}
+	} else if data, err := utils.ReadFile(privateKeyFile); err == nil {
+		_, err := ssh.ParseRawPrivateKey([]byte(data))
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return errno.ERR_PRIVATE_KEY_FILE_REQUIRES_PASSPHRASE.
+				F("%s: %s.private_key_passphrase = nil", privateKeyFile, a.field)
+		}
+	}
+
+	// user certificate
+	if len(a.certificateFile) > 0 {
+		return checkCertificateFile(a.field+".certificate_file", a.certificateFile)
+	}
+	return nil
+}
+
+func checkCertificateFile(field, certificateFile string) error {
+	if !strings.HasPrefix(certificateFile, "/") {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.
+			F("%s = %s: requires an absolute path", field, certificateFile)
+	} else if !utils.PathExist(certificateFile) {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.
+			F("%s = %s: no such file", field, certificateFile)
+	}
+
+	data, err := utils.ReadFile(certificateFile)
+	if err != nil {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.E(err)
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(data))
+	if err != nil {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.
+			F("%s = %s: %v", field, certificateFile, err)
+	}
+	cert, ok := key.(*ssh.Certificate)
+	if !ok || cert.CertType != ssh.UserCert {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.
+			F("%s = %s: not an user certificate", field, certificateFile)
+	}
+
+	now := uint64(time.Now().Unix())
+	if now < cert.ValidAfter || (cert.ValidBefore != ssh.CertTimeInfinity && now >= cert.ValidBefore) {
+		return errno.ERR_INVALID_SSH_CERTIFICATE.
+			F("%s = %s: certificate expired or not yet valid", field, certificateFile)
+	}
+	return nil
+}
+
+func (hc *HostConfig) checkAuth() error {
+	field := fmt.Sprintf("hosts[%d]", hc.sequence)
+	privateKeyFile := hc.GetPrivateKeyFile()
+	switch hc.GetAuthMethod() {
+	case AUTH_METHOD_PUBLICKEY:
+		err := checkPrivateKeyFile(auth{
+			field:           field,
+			privateKeyFile:  privateKeyFile,
+			passphrase:      hc.GetPrivateKeyPassphrase(),
+			certificateFile: hc.GetCertificateFile(),
+			forwardAgent:    hc.GetForwardAgent(),
+		})
+		if err != nil {
+			return err
+		}
+	case AUTH_METHOD_PASSWORD:
+		if hc.GetForwardAgent() {
+			break
+		} else if len(hc.GetPassword()) == 0 {
+			return errno.ERR_PASSWORD_FIELD_MISSING.
+				F("%s.password = nil", field)
+		} else if err := checkSecret(field+".password", hc.GetPassword()); err != nil {
+			return err
+		}
+	default:
+		return errno.ERR_UNSUPPORT_SSH_AUTH_METHOD.
+			F("%s.auth_method = %s", field, hc.GetAuthMethod())
+	}
+
+	for i, jump := range hc.jumps {
+		a := auth{
+			field:           fmt.Sprintf("%s.%s[%d]", field, KEY_PROXY_JUMP, i),
+			privateKeyFile:  jump.PrivateKeyFile,
+			passphrase:      jump.Passphrase,
+			certificateFile: jump.CertificateFile,
+			forwardAgent:    hc.GetForwardAgent(),
+		}
+		if len(a.privateKeyFile) == 0 { // inherit from host
+			a.privateKeyFile = privateKeyFile
+			a.passphrase = hc.GetPrivateKeyPassphrase()
+			a.certificateFile = hc.GetCertificateFile()
+		}
+		if jump.ForwardAgent != nil {
+			a.forwardAgent = *jump.ForwardAgent
+		}
+		if err := checkPrivateKeyFile(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (hc *HostConfig) Build() error {
 	for key, value := range hc.config {
 		if key == KEY_LABELS { // convert labels
@@ -117,6 +442,18 @@ func (hc *HostConfig) Build() error {
 			}
 			hc.config[key] = nil // delete labels section
 			continue
+		} else if key == KEY_PROXY_JUMP { // convert proxy jump
+			if err := hc.convertProxyJump(); err != nil {
+				return err
+			}
+			hc.config[key] = nil // delete proxy jump section
+			continue
+		} else if key == KEY_DISKS { // convert disks
+			if err := hc.convertDisks(); err != nil {
+				return err
+			}
+			hc.config[key] = nil // delete disks section
+			continue
 		}
 
 		if itemset.Get(key) == nil {
@@ -132,7 +469,6 @@ func (hc *HostConfig) Build() error {
 		}
 	}
 
-	privateKeyFile := hc.GetPrivateKeyFile()
 	if len(hc.GetHost()) == 0 {
 		return errno.ERR_HOST_FIELD_MISSING.
 			F("hosts[%d].host = nil", hc.sequence)
@@ -145,21 +481,9 @@ func (hc *HostConfig) Build() error {
 	} else if hc.GetSSHPort() > os.GetMaxPortNum() {
 		return errno.ERR_HOSTS_SSH_PORT_EXCEED_MAX_PORT_NUMBER.
 			F("hosts[%d].ssh_port = %d", hc.sequence, hc.GetSSHPort())
-	} else if !strings.HasPrefix(privateKeyFile, "/") {
-		return errno.ERR_PRIVATE_KEY_FILE_REQUIRE_ABSOLUTE_PATH.
-			F("hosts[%d].private_key_file = %s", hc.sequence, privateKeyFile)
 	}
 
-	if hc.GetForwardAgent() == false {
-		if !utils.PathExist(privateKeyFile) {
-			return errno.ERR_PRIVATE_KEY_FILE_NOT_EXIST.
-				F("%s: no such file", privateKeyFile)
-		} else if utils.GetFilePermissions(privateKeyFile) != PERMISSIONS_600 {
-			return errno.ERR_PRIVATE_KEY_FILE_REQUIRE_600_PERMISSIONS.
-				F("%s: mode (%d)", privateKeyFile, utils.GetFilePermissions(privateKeyFile))
-		}
-	}
-	return nil
+	return hc.checkAuth()
 }
 
 func NewHostConfig(sequence int, config map[string]interface{}) *HostConfig {
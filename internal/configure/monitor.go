@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dingodb/dingocli/cli/cli"
@@ -27,9 +28,11 @@ import (
 	confHost "github.com/dingodb/dingocli/internal/configure/hosts"
 	"github.com/dingodb/dingocli/internal/configure/topology"
 	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/dingodb/dingocli/pkg/variable"
 	"github.com/mitchellh/hashstructure/v2"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -38,6 +41,12 @@ const (
 	ROLE_GRAFANA       = "grafana"
 	ROLE_MONITOR_CONF  = "monitor_conf"
 	ROLE_MONITOR_SYNC  = "monitor_sync"
+	ROLE_ALERTMANAGER  = "alertmanager"
+	ROLE_LOKI          = "loki"
+	ROLE_PROMTAIL      = "promtail"
+
+	// the job of node exporters in the bundled prometheus, which alert rules depend on
+	JOB_NODE_EXPORTER = "node"
 
 	KEY_HOST              = "host"
 	KEY_LISTEN_PORT       = "listen_port"
@@ -46,16 +55,36 @@ const (
 	KEY_PROMETHEUS_TARGET = "target"
 	KEY_GRAFANA_USER      = "username"
 	KEY_GRAFANA_PASSWORD  = "password"
-
-	KEY_NODE_IPS         = "node_ips"
-	KRY_NODE_LISTEN_PORT = "node_listen_port"
-	KEY_PROMETHEUS_IP    = "prometheus_listen_ip"
-	KEY_PROMETHEUS_PORT  = "prometheus_listen_port"
+	KEY_ALERT_RECEIVER    = "receiver"
+	KEY_WEBHOOK_URL       = "webhook.url"
+	KEY_EMAIL_TO          = "email.to"
+	KEY_EMAIL_FROM        = "email.from"
+	KEY_EMAIL_SMARTHOST   = "email.smarthost"
+	KEY_EMAIL_USERNAME    = "email.auth_username"
+	KEY_EMAIL_PASSWORD    = "email.auth_password"
+	KEY_GRPC_LISTEN_PORT  = "grpc_listen_port"
+	KEY_PROMTAIL_TARGET   = "target"
+	KEY_PROMTAIL_LOG_DIRS = "log_dirs"
+	KEY_DASHBOARD_LANG    = "dashboard.language"
+	KEY_DASHBOARD_DIR     = "dashboard.dir"
+
+	KEY_NODE_IPS          = "node_ips"
+	KRY_NODE_LISTEN_PORT  = "node_listen_port"
+	KEY_PROMETHEUS_IP     = "prometheus_listen_ip"
+	KEY_PROMETHEUS_PORT   = "prometheus_listen_port"
+	KEY_ALERTMANAGER_ADDR = "alertmanager_addr"
+	KEY_LOKI_ADDR         = "loki_addr"
 
 	KEY_ORIGIN_CONFIG_ID = "origin_config_id"
 
+	TARGETS_FORMAT_FILE_SD = "file_sd"
+	TARGETS_FORMAT_YAML    = "yaml"
+
 	INFO_TYPE_FILE = "file"
 	INFO_TYPE_DATA = "data"
+
+	RECEIVER_WEBHOOK = "webhook"
+	RECEIVER_EMAIL   = "email"
 )
 
 type (
@@ -74,6 +103,9 @@ type (
 		Prometheus   service                `mapstructure:"prometheus"`
 		Grafana      service                `mapstructure:"grafana"`
 		MonitroSync  service                `mapstructure:"monitor_sync"`
+		Alertmanager service                `mapstructure:"alertmanager"`
+		Loki         service                `mapstructure:"loki"`
+		Promtail     service                `mapstructure:"promtail"`
 	}
 
 	MonitorConfig struct {
@@ -89,8 +121,8 @@ type (
 	}
 
 	serviceTarget struct {
-		Targets []string          `json:"targets"`
-		Labels  map[string]string `json:"labels"`
+		Targets []string          `json:"targets" yaml:"targets"`
+		Labels  map[string]string `json:"labels" yaml:"labels"`
 	}
 
 	FilterMonitorOption struct {
@@ -213,6 +245,62 @@ func (m *MonitorConfig) GetGrafanaPassword() string {
 	return m.getString(&m.config, KEY_GRAFANA_PASSWORD)
 }
 
+func (m *MonitorConfig) GetAlertmanagerAddr() string {
+	return m.getString(&m.config, KEY_ALERTMANAGER_ADDR)
+}
+
+func (m *MonitorConfig) GetAlertReceiver() string {
+	return m.getString(&m.config, KEY_ALERT_RECEIVER)
+}
+
+func (m *MonitorConfig) GetWebhookUrl() string {
+	return m.getString(&m.config, KEY_WEBHOOK_URL)
+}
+
+func (m *MonitorConfig) GetEmailTo() string {
+	return m.getString(&m.config, KEY_EMAIL_TO)
+}
+
+func (m *MonitorConfig) GetEmailFrom() string {
+	return m.getString(&m.config, KEY_EMAIL_FROM)
+}
+
+func (m *MonitorConfig) GetEmailSmarthost() string {
+	return m.getString(&m.config, KEY_EMAIL_SMARTHOST)
+}
+
+func (m *MonitorConfig) GetEmailUsername() string {
+	return m.getString(&m.config, KEY_EMAIL_USERNAME)
+}
+
+func (m *MonitorConfig) GetEmailPassword() string {
+	return m.getString(&m.config, KEY_EMAIL_PASSWORD)
+}
+
+func (m *MonitorConfig) GetLokiAddr() string {
+	return m.getString(&m.config, KEY_LOKI_ADDR)
+}
+
+func (m *MonitorConfig) GetGrpcListenPort() int {
+	return m.getInt(&m.config, KEY_GRPC_LISTEN_PORT)
+}
+
+func (m *MonitorConfig) GetPromtailTarget() string {
+	return m.getString(&m.config, KEY_PROMTAIL_TARGET)
+}
+
+func (m *MonitorConfig) GetPromtailLogDirs() []string {
+	return m.getStrings(&m.config, KEY_PROMTAIL_LOG_DIRS)
+}
+
+func (m *MonitorConfig) GetDashboardLanguage() string {
+	return m.getString(&m.config, KEY_DASHBOARD_LANG)
+}
+
+func (m *MonitorConfig) GetDashboardDir() string {
+	return m.getString(&m.config, KEY_DASHBOARD_DIR)
+}
+
 func (m *MonitorConfig) GetVariables() *variable.Variables { return m.variables }
 
 func (m *MonitorConfig) GetServiceConfig() map[string]interface{} {
@@ -248,30 +336,76 @@ func getHost(c *Monitor, role string) []string {
 			return c.Grafana.Config[KEY_HOST].([]string)
 		}
 		c.Grafana.Config[KEY_HOST] = hosts
+	case ROLE_ALERTMANAGER:
+		if _, ok := c.Alertmanager.Config[KEY_HOST]; ok {
+			return c.Alertmanager.Config[KEY_HOST].([]string)
+		}
+		c.Alertmanager.Config[KEY_HOST] = hosts
+	case ROLE_LOKI:
+		if _, ok := c.Loki.Config[KEY_HOST]; ok {
+			return c.Loki.Config[KEY_HOST].([]string)
+		}
+		c.Loki.Config[KEY_HOST] = hosts
 	}
 	return hosts
 }
 
+// getServiceAddr returns the ip:port of the first host which deploy the role
+func getServiceAddr(c *Monitor, ctx *topology.Context, role string, service service) string {
+	host := getHost(c, role)[0]
+	return fmt.Sprintf("%s:%v", ctx.Lookup(host), service.Config[KEY_LISTEN_PORT])
+}
+
+// checkAlertReceiver checks the receiver of alertmanager is webhook or email with required fields
+func checkAlertReceiver(config map[string]interface{}) error {
+	required := []string{}
+	receiver, _ := config[KEY_ALERT_RECEIVER].(string)
+	switch receiver {
+	case RECEIVER_WEBHOOK:
+		required = append(required, KEY_WEBHOOK_URL)
+	case RECEIVER_EMAIL:
+		required = append(required, KEY_EMAIL_TO, KEY_EMAIL_FROM, KEY_EMAIL_SMARTHOST)
+	default:
+		return errno.ERR_INVALID_ALERT_RECEIVER.F("%s: %s", KEY_ALERT_RECEIVER, receiver)
+	}
+	for _, key := range required {
+		if v, _ := config[key].(string); len(v) == 0 {
+			return errno.ERR_INVALID_ALERT_RECEIVER.F("receiver %s requires %s", receiver, key)
+		}
+	}
+	return nil
+}
+
+func getServiceTargetAddr(dc *topology.DeployConfig) string {
+	ip := dc.GetListenIp()
+	switch dc.GetRole() {
+	case topology.ROLE_ETCD:
+		return fmt.Sprintf("%s:%d", ip, dc.GetListenClientPort())
+	case topology.ROLE_CHUNKSERVER,
+		// topology.ROLE_MDS_V1,
+		topology.ROLE_METASERVER:
+		return fmt.Sprintf("%s:%d", ip, dc.GetListenPort())
+	case topology.ROLE_SNAPSHOTCLONE:
+		return fmt.Sprintf("%s:%d", ip, dc.GetListenDummyPort())
+	case topology.ROLE_FS_MDS,
+		topology.ROLE_COORDINATOR,
+		topology.ROLE_STORE,
+		topology.ROLE_DINGODB_DOCUMENT,
+		topology.ROLE_DINGODB_INDEX,
+		topology.ROLE_DINGODB_DISKANN:
+		return fmt.Sprintf("%s:%d", ip, dc.GetDingoServerPort())
+	}
+	return ""
+}
+
 func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
 	targets := []serviceTarget{}
 	tMap := make(map[string]serviceTarget)
 	for _, dc := range dcs {
 		role := dc.GetRole()
-		ip := dc.GetListenIp()
-		var item string
-		switch role {
-		case topology.ROLE_ETCD:
-			item = fmt.Sprintf("%s:%d", ip, dc.GetListenClientPort())
-		case topology.ROLE_CHUNKSERVER,
-			// topology.ROLE_MDS_V1,
-			topology.ROLE_METASERVER:
-			item = fmt.Sprintf("%s:%d", ip, dc.GetListenPort())
-		case topology.ROLE_SNAPSHOTCLONE:
-			item = fmt.Sprintf("%s:%d", ip, dc.GetListenDummyPort())
-		case topology.ROLE_FS_MDS,
-			topology.ROLE_COORDINATOR,
-			topology.ROLE_STORE:
-			item = fmt.Sprintf("%s:%d", ip, dc.GetDingoServerPort())
+		item := getServiceTargetAddr(dc)
+		if len(item) == 0 { // e.g. executor which has no brpc metrics
+			continue
 		}
 		if _, ok := tMap[role]; ok {
 			t := tMap[role]
@@ -294,6 +428,102 @@ func parsePrometheusTarget(dcs []*topology.DeployConfig) (string, error) {
 	return string(target), nil
 }
 
+// genExternalTargets returns the targets of services and node exporters grouped by role and host,
+// the result is sorted so that it can be compared between two exports.
+func genExternalTargets(dcs []*topology.DeployConfig, cluster string, nodeExporterPort int) []serviceTarget {
+	groups := map[string]*serviceTarget{}
+	hostIps := map[string]string{}
+	add := func(job, role, host, addr string) {
+		key := role + "/" + host
+		if _, ok := groups[key]; !ok {
+			groups[key] = &serviceTarget{
+				Labels: map[string]string{
+					"job":     job,
+					"role":    role,
+					"host":    host,
+					"cluster": cluster,
+				},
+			}
+		}
+		groups[key].Targets = append(groups[key].Targets, addr)
+	}
+	for _, dc := range dcs {
+		if _, ok := hostIps[dc.GetHost()]; !ok {
+			hostIps[dc.GetHost()] = dc.GetListenIp()
+		}
+		if addr := getServiceTargetAddr(dc); len(addr) > 0 {
+			add(dc.GetRole(), dc.GetRole(), dc.GetHost(), addr)
+		}
+	}
+	if nodeExporterPort > 0 {
+		for host, ip := range hostIps {
+			add(JOB_NODE_EXPORTER, ROLE_NODE_EXPORTER, host, fmt.Sprintf("%s:%d", ip, nodeExporterPort))
+		}
+	}
+
+	keys := []string{}
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	targets := []serviceTarget{}
+	for _, key := range keys {
+		sort.Strings(groups[key].Targets)
+		targets = append(targets, *groups[key])
+	}
+	return targets
+}
+
+// ExportPrometheusTargets renders targets for the prometheus which not deployed by dingo,
+// file_sd is JSON and yaml is YAML, both can be loaded by prometheus file_sd_configs.
+func ExportPrometheusTargets(dcs []*topology.DeployConfig, cluster string,
+	nodeExporterPort int, format string) (string, error) {
+	targets := genExternalTargets(dcs, cluster, nodeExporterPort)
+	var data []byte
+	var err error
+	switch format {
+	case TARGETS_FORMAT_FILE_SD:
+		data, err = json.MarshalIndent(targets, "", "  ")
+	case TARGETS_FORMAT_YAML:
+		data, err = yaml.Marshal(targets)
+	default:
+		return "", errno.ERR_INVALID_TARGETS_FORMAT.F("format: %s", format)
+	}
+	if err != nil {
+		return "", errno.ERR_PARSE_PROMETHEUS_TARGET_FAILED.E(err)
+	}
+	return strings.TrimSuffix(string(data), "\n") + "\n", nil
+}
+
+// parsePromtailTarget returns the log targets and log directories of services which deployed
+// on the host, every log directory is mounted into promtail container at the same path.
+func parsePromtailTarget(dcs []*topology.DeployConfig, host string) (string, []string, error) {
+	targets := []serviceTarget{}
+	logDirs := []string{}
+	for _, dc := range dcs {
+		if dc.GetHost() != host || len(dc.GetLogDir()) == 0 {
+			continue
+		}
+		if !utils.Contains(logDirs, dc.GetLogDir()) {
+			logDirs = append(logDirs, dc.GetLogDir())
+		}
+		targets = append(targets, serviceTarget{
+			Targets: []string{"localhost"},
+			Labels: map[string]string{
+				"job":      dc.GetRole(),
+				"host":     dc.GetHost(),
+				"instance": dc.GetId(),
+				"__path__": fmt.Sprintf("%s/**/*", dc.GetLogDir()),
+			},
+		})
+	}
+	target, err := json.Marshal(targets)
+	if err != nil {
+		return "", nil, errno.ERR_PARSE_PROMTAIL_TARGET_FAILED.E(err)
+	}
+	return string(target), logDirs, nil
+}
+
 func parseHosts(dingocli *cli.DingoCli) ([]string, []string, []*topology.DeployConfig, error) {
 	dcs, err := dingocli.ParseTopology()
 	if err != nil || len(dcs) == 0 {
@@ -381,10 +611,25 @@ func ParseMonitorInfo(dingocli *cli.DingoCli, info string, infoType string) ([]*
 	case config.MonitroSync.Config != nil:
 		roles = append(roles, ROLE_MONITOR_SYNC)
 	}
+	if config.Alertmanager.Deploy != nil {
+		roles = append(roles, ROLE_ALERTMANAGER)
+	}
+	if config.Loki.Deploy != nil {
+		roles = append(roles, ROLE_LOKI)
+	}
+	if config.Promtail.Deploy != nil {
+		if config.Loki.Deploy == nil {
+			return nil, errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.F("promtail requires loki")
+		}
+		roles = append(roles, ROLE_PROMTAIL)
+	}
 	ret := []*MonitorConfig{}
 	for _, role := range roles {
-		// prometheus/grafana use as default host
-		serviceHosts := getHost(&config, role)
+		// prometheus/grafana use as default host, promtail is deployed on every host of cluster
+		serviceHosts := hosts
+		if role != ROLE_PROMTAIL {
+			serviceHosts = getHost(&config, role)
+		}
 		host := serviceHosts[0]
 		switch role {
 		case ROLE_PROMETHEUS:
@@ -399,6 +644,9 @@ func ParseMonitorInfo(dingocli *cli.DingoCli, info string, infoType string) ([]*
 				config.Prometheus.Config[KEY_DATA_DIR] = syncMonitorPath + "/" + ROLE_PROMETHEUS + "/data"
 			}
 			config.Prometheus.Config[KEY_PROMETHEUS_TARGET] = target
+			if config.Alertmanager.Deploy != nil {
+				config.Prometheus.Config[KEY_ALERTMANAGER_ADDR] = getServiceAddr(&config, ctx, ROLE_ALERTMANAGER, config.Alertmanager)
+			}
 			ret = append(ret, &MonitorConfig{
 				kind:   mkind,
 				id:     fmt.Sprintf("%s_%s", role, host),
@@ -416,6 +664,9 @@ func ParseMonitorInfo(dingocli *cli.DingoCli, info string, infoType string) ([]*
 				config.Grafana.Config[KEY_DATA_DIR] = syncMonitorPath + "/" + ROLE_GRAFANA + "/data"
 				config.Grafana.Config[KEY_PROVISIONING_DIR] = syncMonitorPath + "/" + ROLE_GRAFANA + "/provisioning"
 			}
+			if config.Loki.Deploy != nil {
+				config.Grafana.Config[KEY_LOKI_ADDR] = getServiceAddr(&config, ctx, ROLE_LOKI, config.Loki)
+			}
 			ret = append(ret, &MonitorConfig{
 				kind:   mkind,
 				id:     fmt.Sprintf("%s_%s", role, host),
@@ -426,6 +677,60 @@ func ParseMonitorInfo(dingocli *cli.DingoCli, info string, infoType string) ([]*
 				order:  3,
 			},
 			)
+		case ROLE_ALERTMANAGER:
+			if err := checkAlertReceiver(config.Alertmanager.Config); err != nil {
+				return nil, err
+			}
+			config.Alertmanager.Config[KEY_CONF_DIR] = syncMonitorPath + "/" + ROLE_ALERTMANAGER
+			config.Alertmanager.Config[KEY_DATA_DIR] = syncMonitorPath + "/" + ROLE_ALERTMANAGER + "/data"
+			ret = append(ret, &MonitorConfig{
+				kind:   mkind,
+				id:     fmt.Sprintf("%s_%s", role, host),
+				role:   role,
+				host:   host,
+				config: config.Alertmanager.Config,
+				ctx:    ctx,
+				order:  2,
+			})
+		case ROLE_LOKI:
+			config.Loki.Config[KEY_CONF_DIR] = syncMonitorPath + "/" + ROLE_LOKI
+			config.Loki.Config[KEY_DATA_DIR] = syncMonitorPath + "/" + ROLE_LOKI + "/data"
+			ret = append(ret, &MonitorConfig{
+				kind:   mkind,
+				id:     fmt.Sprintf("%s_%s", role, host),
+				role:   role,
+				host:   host,
+				config: config.Loki.Config,
+				ctx:    ctx,
+				order:  2,
+			})
+		case ROLE_PROMTAIL:
+			config.Promtail.Config[KEY_LOKI_ADDR] = getServiceAddr(&config, ctx, ROLE_LOKI, config.Loki)
+			config.Promtail.Config[KEY_CONF_DIR] = syncMonitorPath + "/" + ROLE_PROMTAIL
+			config.Promtail.Config[KEY_DATA_DIR] = syncMonitorPath + "/" + ROLE_PROMTAIL + "/data"
+			for hostSequence, h := range hosts {
+				// every promtail only tails the logs of services on its host
+				target, logDirs, err := parsePromtailTarget(dcs, h)
+				if err != nil {
+					return nil, err
+				}
+				promtailConfig := map[string]interface{}{}
+				for k, v := range config.Promtail.Config {
+					promtailConfig[k] = v
+				}
+				promtailConfig[KEY_PROMTAIL_TARGET] = target
+				promtailConfig[KEY_PROMTAIL_LOG_DIRS] = logDirs
+				ret = append(ret, &MonitorConfig{
+					kind:         mkind,
+					id:           fmt.Sprintf("%s_%s", role, h),
+					role:         role,
+					host:         h,
+					hostSequence: hostSequence,
+					config:       promtailConfig,
+					ctx:          ctx,
+					order:        3,
+				})
+			}
 		case ROLE_NODE_EXPORTER:
 			for hostSequence, h := range hosts {
 				ret = append(ret, &MonitorConfig{
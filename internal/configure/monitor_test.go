@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package configure
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"gopkg.in/yaml.v3"
+)
+
+const TARGETS_TOPOLOGY = `
+kind: dingo-store
+global:
+  container_image: dingodatabase/dingo-store:latest
+  variable:
+    machine1: host1
+    machine2: host2
+
+coordinator_services:
+  config:
+    server.port: 6500
+    raft.port: 7500
+  deploy:
+    - host: ${machine1}
+    - host: ${machine2}
+
+store_services:
+  config:
+    raft.port: 7600
+  deploy:
+    - host: ${machine2}
+      config:
+        server.port: 6601
+    - host: ${machine1}
+      config:
+        server.port: 6600
+    - host: ${machine1}
+      config:
+        server.port: 6602
+`
+
+func parseTargetsTopology(t *testing.T, data string) []*topology.DeployConfig {
+	ctx := topology.NewContext()
+	ctx.Add("host1", "10.0.0.1")
+	ctx.Add("host2", "10.0.0.2")
+	dcs, err := topology.ParseTopology(data, ctx)
+	if err != nil {
+		t.Fatalf("ParseTopology() error: %v", err)
+	}
+	return dcs
+}
+
+/*
+ * TestGenExternalTargets, run: go test ./internal/configure -run ^TestGenExternalTargets$
+ */
+func TestGenExternalTargets(t *testing.T) {
+	dcs := parseTargetsTopology(t, TARGETS_TOPOLOGY)
+	labels := func(role, host string) map[string]string {
+		return map[string]string{"job": role, "role": role, "host": host, "cluster": "c1"}
+	}
+	nodeLabels := func(host string) map[string]string {
+		return map[string]string{"job": JOB_NODE_EXPORTER, "role": ROLE_NODE_EXPORTER, "host": host, "cluster": "c1"}
+	}
+
+	tests := []struct {
+		name             string
+		nodeExporterPort int
+		expect           []serviceTarget
+	}{
+		{
+			"services only",
+			0,
+			[]serviceTarget{
+				{Targets: []string{"10.0.0.1:6500"}, Labels: labels("coordinator", "host1")},
+				{Targets: []string{"10.0.0.2:6500"}, Labels: labels("coordinator", "host2")},
+				{Targets: []string{"10.0.0.1:6600", "10.0.0.1:6602"}, Labels: labels("store", "host1")},
+				{Targets: []string{"10.0.0.2:6601"}, Labels: labels("store", "host2")},
+			},
+		},
+		{
+			"with node exporters",
+			9100,
+			[]serviceTarget{
+				{Targets: []string{"10.0.0.1:6500"}, Labels: labels("coordinator", "host1")},
+				{Targets: []string{"10.0.0.2:6500"}, Labels: labels("coordinator", "host2")},
+				{Targets: []string{"10.0.0.1:9100"}, Labels: nodeLabels("host1")},
+				{Targets: []string{"10.0.0.2:9100"}, Labels: nodeLabels("host2")},
+				{Targets: []string{"10.0.0.1:6600", "10.0.0.1:6602"}, Labels: labels("store", "host1")},
+				{Targets: []string{"10.0.0.2:6601"}, Labels: labels("store", "host2")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := genExternalTargets(dcs, "c1", tt.nodeExporterPort)
+			if !reflect.DeepEqual(targets, tt.expect) {
+				t.Errorf("genExternalTargets() = %+v, expect %+v", targets, tt.expect)
+			}
+		})
+	}
+}
+
+/*
+ * TestExportPrometheusTargets, run: go test ./internal/configure -run ^TestExportPrometheusTargets$
+ */
+func TestExportPrometheusTargets(t *testing.T) {
+	dcs := parseTargetsTopology(t, TARGETS_TOPOLOGY)
+
+	tests := []struct {
+		format string
+		err    bool
+	}{
+		{TARGETS_FORMAT_FILE_SD, false},
+		{TARGETS_FORMAT_YAML, false},
+		{"xml", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			content, err := ExportPrometheusTargets(dcs, "c1", 9100, tt.format)
+			if (err != nil) != tt.err {
+				t.Fatalf("ExportPrometheusTargets() error = %v, expect error %v", err, tt.err)
+			} else if tt.err {
+				return
+			}
+
+			targets := []serviceTarget{}
+			if tt.format == TARGETS_FORMAT_FILE_SD {
+				err = json.Unmarshal([]byte(content), &targets)
+			} else {
+				err = yaml.Unmarshal([]byte(content), &targets)
+			}
+			if err != nil {
+				t.Fatalf("unmarshal %s targets error: %v\n%s", tt.format, err, content)
+			} else if !reflect.DeepEqual(targets, genExternalTargets(dcs, "c1", 9100)) {
+				t.Errorf("ExportPrometheusTargets() = %s, expect %+v", content, genExternalTargets(dcs, "c1", 9100))
+			}
+			if !strings.HasSuffix(content, "\n") || strings.HasSuffix(content, "\n\n") {
+				t.Errorf("ExportPrometheusTargets() expect one trailing newline, got %q", content)
+			}
+		})
+	}
+}
+
+/*
+ * TestExportPrometheusTargetsUnchanged, run: go test ./internal/configure -run ^TestExportPrometheusTargetsUnchanged$
+ */
+func TestExportPrometheusTargetsUnchanged(t *testing.T) {
+	dcs := parseTargetsTopology(t, TARGETS_TOPOLOGY)
+	content, err := ExportPrometheusTargets(dcs, "c1", 9100, TARGETS_FORMAT_FILE_SD)
+	if err != nil {
+		t.Fatalf("ExportPrometheusTargets() error: %v", err)
+	}
+
+	// the order of services in topology doesn't change the content, so watch won't rewrite the file
+	reversed := []*topology.DeployConfig{}
+	for i := len(dcs) - 1; i >= 0; i-- {
+		reversed = append(reversed, dcs[i])
+	}
+	for i := 0; i < 3; i++ {
+		latest, err := ExportPrometheusTargets(reversed, "c1", 9100, TARGETS_FORMAT_FILE_SD)
+		if err != nil {
+			t.Fatalf("ExportPrometheusTargets() error: %v", err)
+		} else if latest != content {
+			t.Fatalf("ExportPrometheusTargets() changed for the same topology:\n%s\n---\n%s", content, latest)
+		}
+	}
+
+	// scale out one store, the content changed
+	scaled := strings.Replace(TARGETS_TOPOLOGY, "        server.port: 6601", "        server.port: 6601\n    - host: ${machine2}\n      config:\n        server.port: 6603", 1)
+	latest, err := ExportPrometheusTargets(parseTargetsTopology(t, scaled), "c1", 9100, TARGETS_FORMAT_FILE_SD)
+	if err != nil {
+		t.Fatalf("ExportPrometheusTargets() error: %v", err)
+	} else if latest == content || !strings.Contains(latest, "10.0.0.2:6603") {
+		t.Errorf("ExportPrometheusTargets() expect new target 10.0.0.2:6603, got:\n%s", latest)
+	}
+}
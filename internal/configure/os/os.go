@@ -16,12 +16,135 @@
 
 package os
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	PATH_FSTAB      = "/etc/fstab"
 	PATH_OS_RELEASE = "/etc/os-release"
 	MAX_PORT        = 65535
+
+	PATH_SYSCTL_DIR  = "/etc/sysctl.d"
+	PATH_LIMITS_CONF = "/etc/security/limits.conf"
+	PATH_LIMITS_DIR  = "/etc/security/limits.d"
+	PATH_SYSTEMD_DIR = "/etc/systemd/system"
+	PATH_FUSE_CONF   = "/etc/fuse.conf"
+
+	OS_FAMILY_DEBIAN  = "debian"
+	OS_FAMILY_RHEL    = "rhel"
+	OS_FAMILY_UNKNOWN = "unknown"
+
+	LIMIT_UNSET = "unset"
+)
+
+// Release is the operating system identification from /etc/os-release
+type Release struct {
+	Id         string
+	IdLike     []string
+	Name       string
+	VersionId  string
+	PrettyName string
+}
+
+var (
+	familyIds = map[string]string{
+		"debian":    OS_FAMILY_DEBIAN,
+		"ubuntu":    OS_FAMILY_DEBIAN,
+		"rhel":      OS_FAMILY_RHEL,
+		"centos":    OS_FAMILY_RHEL,
+		"fedora":    OS_FAMILY_RHEL,
+		"rocky":     OS_FAMILY_RHEL,
+		"almalinux": OS_FAMILY_RHEL,
+		"openEuler": OS_FAMILY_RHEL,
+		"kylin":     OS_FAMILY_RHEL,
+	}
 )
 
 func GetFSTabPath() string     { return PATH_FSTAB }
 func GetMaxPortNum() int       { return MAX_PORT }
 func GetOSReleasePath() string { return PATH_OS_RELEASE }
+func GetSysctlDir() string     { return PATH_SYSCTL_DIR }
+func GetLimitsDir() string     { return PATH_LIMITS_DIR }
+func GetSystemdDir() string    { return PATH_SYSTEMD_DIR }
+func GetFuseConfPath() string  { return PATH_FUSE_CONF }
+
+// ReadLimitsCommand prints limits.conf and the files under limits.d in the order pam_limits reads them
+func ReadLimitsCommand() string {
+	return fmt.Sprintf("bash -c 'cat %s %s/*.conf 2>/dev/null; true'", PATH_LIMITS_CONF, PATH_LIMITS_DIR)
+}
+
+/*
+ * ParseLimits returns the soft limits for all users ('*') by item, the content is
+ * printed by ReadLimitsCommand, so the latter one wins. e.g.
+ *   * soft nofile 65536
+ *   *  -   nproc  1048576
+ */
+func ParseLimits(content string) map[string]string {
+	limits := map[string]string{}
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[0] != "*" {
+			continue
+		} else if fields[1] == "soft" || fields[1] == "-" {
+			limits[fields[2]] = fields[3]
+		}
+	}
+	return limits
+}
+
+// GetLimit returns the soft limit of item, LIMIT_UNSET if it's not configured
+func GetLimit(limits map[string]string, item string) string {
+	if value, ok := limits[item]; ok {
+		return value
+	}
+	return LIMIT_UNSET
+}
+
+func ParseOSRelease(content string) Release {
+	release := Release{}
+	for _, line := range strings.Split(content, "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.Trim(kv[1], "\"'")
+		switch kv[0] {
+		case "ID":
+			release.Id = value
+		case "ID_LIKE":
+			release.IdLike = strings.Fields(value)
+		case "NAME":
+			release.Name = value
+		case "VERSION_ID":
+			release.VersionId = value
+		case "PRETTY_NAME":
+			release.PrettyName = value
+		}
+	}
+	if len(release.PrettyName) == 0 {
+		release.PrettyName = strings.TrimSpace(release.Name + " " + release.VersionId)
+	}
+	return release
+}
+
+// Family returns the distribution family which decides the package manager
+func (r Release) Family() string {
+	for _, id := range append([]string{r.Id}, r.IdLike...) {
+		if family, ok := familyIds[id]; ok {
+			return family
+		}
+	}
+	return OS_FAMILY_UNKNOWN
+}
+
+func (r Release) InstallPackageCommand(pkg string) (string, error) {
+	switch r.Family() {
+	case OS_FAMILY_DEBIAN:
+		return fmt.Sprintf("env DEBIAN_FRONTEND=noninteractive apt-get install -y %s", pkg), nil
+	case OS_FAMILY_RHEL:
+		return fmt.Sprintf("yum install -y %s", pkg), nil
+	}
+	return "", fmt.Errorf("unsupported distribution: %s", r.Id)
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package os
+
+import (
+	"testing"
+)
+
+/*
+ * TestParseLimits, run: go test ./internal/configure/os -run ^TestParseLimits$
+ */
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		nofile  string
+		nproc   string
+	}{
+		{"empty", "", LIMIT_UNSET, LIMIT_UNSET},
+		{"soft", "* soft nofile 65536\n* hard nofile 65536\n", "65536", LIMIT_UNSET},
+		{"dash", "*  -  nofile 1048576\n* - nproc unlimited\n", "1048576", "unlimited"},
+		{"hard only", "* hard nofile 65536\n", LIMIT_UNSET, LIMIT_UNSET},
+		{"other users", "root soft nofile 4096\n@admin soft nproc 8192\n", LIMIT_UNSET, LIMIT_UNSET},
+		{"comments", "# * soft nofile 1024\n* soft nofile 65536\n", "65536", LIMIT_UNSET},
+		{"latter wins", "* soft nproc 4096\n# generated by dingo hosts tune\n* soft nproc 1048576\n", LIMIT_UNSET, "1048576"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := ParseLimits(tt.content)
+			if nofile := GetLimit(limits, "nofile"); nofile != tt.nofile {
+				t.Errorf("expect nofile %s, got %s", tt.nofile, nofile)
+			}
+			if nproc := GetLimit(limits, "nproc"); nproc != tt.nproc {
+				t.Errorf("expect nproc %s, got %s", tt.nproc, nproc)
+			}
+		})
+	}
+}
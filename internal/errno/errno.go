@@ -228,15 +228,25 @@ var (
 	// 115: database/SQL (execute SQL statement: audit table)
 	ERR_GET_AUDIT_LOGS_FAILE = EC(115000, "execute SQL failed which get audit logs")
 	// 116: database/SQL (execute SQL statement: any table)
-	ERR_INSERT_CLIENT_CONFIG_FAILED = EC(116000, "execute SQL failed which insert client config")
-	ERR_SELECT_CLIENT_CONFIG_FAILED = EC(116001, "execute SQL failed which select client config")
-	ERR_DELETE_CLIENT_CONFIG_FAILED = EC(116002, "execute SQL failed which delete client config")
+	ERR_INSERT_CLIENT_CONFIG_FAILED  = EC(116000, "execute SQL failed which insert client config")
+	ERR_SELECT_CLIENT_CONFIG_FAILED  = EC(116001, "execute SQL failed which select client config")
+	ERR_DELETE_CLIENT_CONFIG_FAILED  = EC(116002, "execute SQL failed which delete client config")
+	ERR_REPLACE_HOST_FACTS_FAILED    = EC(116003, "execute SQL failed which replace host facts")
+	ERR_SELECT_HOST_FACTS_FAILED     = EC(116004, "execute SQL failed which select host facts")
+	ERR_REPLACE_TUNE_SNAPSHOT_FAILED = EC(116005, "execute SQL failed which replace tune snapshot")
+	ERR_SELECT_TUNE_SNAPSHOT_FAILED  = EC(116006, "execute SQL failed which select tune snapshot")
+	ERR_DELETE_TUNE_SNAPSHOT_FAILED  = EC(116007, "execute SQL failed which delete tune snapshot")
 	// 117: database/SQL (execute SQL statement: monitor table)
 	ERR_GET_MONITOR_FAILED     = EC(117000, "execute SQL failed while get monitor")
 	ERR_REPLACE_MONITOR_FAILED = EC(117001, "execute SQL failed while replace monitor")
 	ERR_UPDATE_MONITOR_FAILED  = EC(117002, "execute SQL failed while update monitor")
 
 	// 200: command options (hosts)
+	ERR_UNSUPPORT_FACTS_FORMAT  = EC(200000, "unsupport facts format (table/json)")
+	ERR_HOST_FACTS_NOT_CACHED   = EC(200001, "host facts not cached, please collect them by 'dingo hosts facts'")
+	ERR_NO_DISKS_DECLARED       = EC(200002, "no disks declared in hosts, please add disks section into hosts")
+	ERR_TUNE_SNAPSHOT_NOT_FOUND = EC(200003, "tune snapshot not found, the host has not been tuned by 'dingo hosts tune'")
+	ERR_CONFLICT_TUNE_MODE      = EC(200004, "--check and --revert can't be specified at the same time")
 
 	// 210: command options (cluster)
 	ERR_ID_NOT_FOUND                   = EC(210000, "id not found")
@@ -247,6 +257,12 @@ var (
 	ERR_NO_SERVICES_MATCHED            = EC(210006, "no services matched")
 	ERR_UNSUPPORT_DINGODB_ROLE         = EC(210007, "unsupport dingodb role (coordinator/store/executor/document/index/diskann/proxy/web)")
 	ERR_UNSUPPORT_DINGOSTORE_ROLE      = EC(210008, "unsupport dingo-store role (coordinator/store/document/index/diskann)")
+	ERR_INVALID_LOGS_SINCE             = EC(210009, "invalid since, requires duration (e.g. 30m) or timestamp (e.g. 2026-01-02T15:04:05)")
+	ERR_CORE_FILE_NOT_FOUND            = EC(210010, "core file not found, please list core files by 'dingo cluster cores list'")
+	ERR_UNSUPPORT_CHECK_NAME           = EC(210011, "unsupport check name")
+	ERR_UNSUPPORT_REPORT_FORMAT        = EC(210012, "unsupport report format, the report file must end with .json or .html")
+	ERR_INVALID_REFRESH_INTERVAL       = EC(210013, "invalid refresh interval, requires positive duration (e.g. 5s)")
+	ERR_INVALID_NETPERF_OPTION         = EC(210014, "invalid netperf option, pings and duration require positive values")
 	// TODO: please check pool set disk type
 	ERR_INVALID_DISK_TYPE = EC(210007, "poolset disk type must be lowercase and can only be one of ssd, hdd and nvme")
 
@@ -267,6 +283,15 @@ var (
 	ERR_VOLUME_BLOCKSIZE_BE_MULTIPLE_OF_512        = EC(221011, "volume block size be a multiple of 512B, like 1KiB, 2KiB, 3KiB...")
 	// 222: command options (client/fs)
 	ERR_FS_MOUNTPOINT_REQUIRE_ABSOLUTE_PATH = EC(222000, "mount point must be an absolute path")
+	ERR_START_FS_EXPORTER_FAILED            = EC(222001, "start filesystem exporter failed")
+	ERR_UNSUPPORT_STATS_FORMAT              = EC(222002, "unsupport stats format (table/csv)")
+	ERR_RECORD_STATS_FAILED                 = EC(222003, "record mountpoint stats failed")
+	ERR_READ_STATS_RECORD_FAILED            = EC(222004, "read mountpoint stats record failed")
+	ERR_INVALID_STATS_TIME_RANGE            = EC(222005, "invalid time range, requires timestamp (e.g. 2026-01-02T15:04:05) or offset from the record start (e.g. 5m)")
+	ERR_READ_MDS_METRIC_FAILED              = EC(222006, "read mds metric failed")
+	ERR_READ_CACHE_METRIC_FAILED            = EC(222007, "read cache member metric failed")
+	ERR_CACHE_MEMBER_UNREACHABLE            = EC(222008, "cache member endpoint is unreachable, please check the ip and port")
+	ERR_INVALID_STATS_SCHEMA                = EC(222009, "invalid stats schema")
 
 	// 301: configure (common: invalid configure value)
 	ERR_UNSUPPORT_CONFIGURE_VALUE_TYPE = EC(301000, "unsupport configure value type")
@@ -286,6 +311,7 @@ var (
 	ERR_UNSUPPORT_DINGOADM_LOG_LEVEL      = EC(311000, "unsupport dingocli log level")
 	ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM = EC(311001, "unsupport dingocli configure item")
 	ERR_UNSUPPORT_DINGOADM_DATABASE_URL   = EC(311002, "unsupport dingocli database url")
+	ERR_UNSUPPORT_SSH_HOST_KEY_POLICY     = EC(311003, "unsupport SSH host key policy, requires strict, accept-new or ask")
 
 	// 320: configure (hosts.yaml: parse failed)
 	ERR_HOSTS_FILE_NOT_FOUND   = EC(320000, "hosts file not found")
@@ -302,11 +328,29 @@ var (
 	ERR_PRIVATE_KEY_FILE_REQUIRE_600_PERMISSIONS = EC(321006, "SSH private key file require 600 permissions")
 	ERR_DUPLICATE_HOST                           = EC(321007, "host is duplicate")
 	ERR_HOSTNAME_REQUIRES_VALID_IP_ADDRESS       = EC(321008, "hostname requires valid IP address")
+	ERR_INVALID_PROXY_JUMP                       = EC(321009, "proxy_jump requires [user@]host[:port] or list of them")
+	ERR_UNSUPPORT_SSH_AUTH_METHOD                = EC(321010, "unsupport SSH auth method")
+	ERR_PASSWORD_FIELD_MISSING                   = EC(321011, "password field missing")
+	ERR_INVALID_SSH_SECRET                       = EC(321012, "SSH secret requires prompt, env:NAME or file:/path")
+	ERR_PRIVATE_KEY_FILE_REQUIRES_PASSPHRASE     = EC(321013, "SSH private key file is encrypted, passphrase required")
+	ERR_INVALID_SSH_CERTIFICATE                  = EC(321014, "invalid SSH user certificate")
+	ERR_READ_SSH_SECRET_FAILED                   = EC(321015, "read SSH secret failed")
+	ERR_INVALID_HOSTS_DISK                       = EC(321016, "invalid disk, requires device, mount_point and optional filesystem (ext4/xfs), options")
 
 	// 322: configure (monitor.yaml: parse failed)
 	ERR_PARSE_MONITOR_CONFIGURE_FAILED = EC(322000, "parse monitor configure failed")
 	ERR_READ_MONITOR_FILE_FAILED       = EC(322001, "read monitor file failed")
 	ERR_PARSE_PROMETHEUS_TARGET_FAILED = EC(322002, "parse prometheus targets failed")
+	ERR_INVALID_ALERT_RECEIVER         = EC(322003, "invalid alertmanager receiver, requires webhook (webhook.url) or email (email.to, email.from, email.smarthost)")
+	ERR_INVALID_ALERT_RULES            = EC(322004, "invalid alert rules")
+	ERR_READ_ALERT_RULES_FAILED        = EC(322005, "read alert rules file failed")
+	ERR_PARSE_PROMTAIL_TARGET_FAILED   = EC(322006, "parse promtail targets failed")
+	ERR_READ_GRAFANA_DASHBOARD_FAILED  = EC(322007, "read grafana dashboard failed")
+	ERR_INVALID_GRAFANA_DASHBOARD      = EC(322008, "invalid grafana dashboard, requires uid and title")
+	ERR_GRAFANA_API_REQUEST_FAILED     = EC(322009, "grafana api request failed")
+	ERR_WRITE_GRAFANA_DASHBOARD_FAILED = EC(322010, "write grafana dashboard failed")
+	ERR_INVALID_TARGETS_FORMAT         = EC(322011, "invalid targets format, requires file_sd or yaml")
+	ERR_WRITE_TARGETS_FILE_FAILED      = EC(322012, "write prometheus targets file failed")
 
 	// 330: configure (topology.yaml: parse failed)
 	ERR_TOPOLOGY_FILE_NOT_FOUND         = EC(330000, "topology file not found")
@@ -389,6 +433,16 @@ var (
 	ERR_ENCRYPT_FILE_FAILED                  = EC(410021, "encrypt file failed")
 	ERR_CLIENT_ID_NOT_FOUND                  = EC(410022, "client id not found")
 	ERR_ENABLE_ETCD_AUTH_FAILED              = EC(410023, "enable etcd auth failed")
+	ERR_GET_SERVICE_LOGS_FAILED              = EC(410024, "get service logs failed")
+	ERR_DISK_HAS_DATA                        = EC(410025, "disk has filesystem or partitions, use --force to format it")
+	ERR_DISK_MOUNTED_ELSEWHERE               = EC(410026, "disk is mounted on other directory, please umount it first")
+	ERR_UPDATE_FSTAB_FAILED                  = EC(410027, "update /etc/fstab failed")
+	ERR_HOST_TUNING_DRIFT                    = EC(410028, "host tuning drifts from the profile, please apply it by 'dingo hosts tune'")
+	ERR_TUNE_HOST_FAILED                     = EC(410029, "tune host failed")
+	ERR_NETPERF_UNSUPPORTED_PLATFORM         = EC(410030, "netperf requires dingo running on linux")
+	ERR_NETPERF_ARCH_MISMATCH                = EC(410031, "architecture of host mismatch with local dingo binary")
+	ERR_START_NETPERF_SERVER_FAILED          = EC(410032, "start netperf server failed")
+	ERR_UMOUNT_DISK_FAILED                   = EC(410033, "umount disk failed")
 
 	// 430: common (dingofs client)
 	ERR_FS_PATH_ALREADY_MOUNTED    = EC(430000, "path already mounted")
@@ -430,7 +484,11 @@ var (
 	ERR_STORE_REQUIRES_3_SERVICES         = EC(503011, "store requires at least 3 services")
 
 	// 510: checker (ssh)
-	ERR_SSH_CONNECT_FAILED = EC(510000, "SSH connect failed")
+	ERR_SSH_CONNECT_FAILED     = EC(510000, "SSH connect failed")
+	ERR_SSH_HOST_KEY_MISMATCH  = EC(510001, "SSH host key mismatch, the host may be reinstalled or under man-in-the-middle attack")
+	ERR_SSH_HOST_KEY_UNKNOWN   = EC(510002, "SSH host key unknown, please run 'dingo hosts trust' first")
+	ERR_SSH_HOST_KEY_REJECTED  = EC(510003, "SSH host key rejected")
+	ERR_SSH_AUTH_NOT_SUPPORTED = EC(510004, "SSH password or encrypted private key is not supported by non-interactive OpenSSH command, please use private key without passphrase or ssh agent")
 
 	// 520: checker (permission)
 	ERR_USER_NOT_FOUND                                     = EC(520000, "user not found")
@@ -462,6 +520,20 @@ var (
 	ERR_INVALID_DINGOFS_CLIENT_S3_ADDRESS     = EC(570002, "invalid dingofs client S3 address")
 	ERR_INVALID_DINGOFS_CLIENT_S3_BUCKET_NAME = EC(570003, "invalid dingofs client S3 bucket name")
 
+	// 580: checker (system)
+	ERR_OPEN_FILES_LIMIT_TOO_LOW   = EC(580000, "open files limit (ulimit -n) too low, please raise nofile in /etc/security/limits.conf or run 'dingo hosts tune'")
+	ERR_MAX_USER_PROCESSES_TOO_LOW = EC(580001, "max user processes (ulimit -u) too low, please raise nproc in /etc/security/limits.conf")
+	ERR_FILE_MAX_TOO_LOW           = EC(580002, "system-wide file descriptors limit (fs.file-max) too low, please raise it by sysctl")
+	ERR_INSUFFICIENT_MEMORY        = EC(580003, "available memory insufficient, please free memory or reduce services on host")
+	ERR_INSUFFICIENT_DISK_SPACE    = EC(580004, "free space of data directory insufficient, please clean up or mount a larger disk")
+	ERR_UNRECOMMENDED_FILESYSTEM   = EC(580005, "filesystem of data directory is not recommended, please use ext4 or xfs (see 'dingo hosts disk prepare')")
+	ERR_FUSE_DEVICE_NOT_FOUND      = EC(580006, "/dev/fuse not found, please load fuse module by 'modprobe fuse'")
+	ERR_DOCKER_VERSION_TOO_OLD     = EC(580007, "docker version too old, please upgrade docker")
+	ERR_NTP_NOT_SYNCHRONIZED       = EC(580008, "clock is not synchronized by NTP, please install and start chrony or ntpd")
+	ERR_NTP_OFFSET_TOO_LARGE       = EC(580009, "clock offset to NTP server too large, please check the NTP servers of chrony or ntpd")
+	ERR_RDMA_DEVICE_NOT_FOUND      = EC(580010, "RDMA device not found while enable_rdma is set, please check the RDMA driver by 'ibv_devices'")
+	ERR_CHECKER_ALREADY_REGISTERED = EC(580011, "checker already registered")
+
 	// 590: checker (others)
 	ERR_CONTAINER_ENGINE_NOT_INSTALLED = EC(590000, "container engine docker/podman not installed")
 	ERR_DOCKER_DAEMON_IS_NOT_RUNNING   = EC(590001, "docker daemon is not running")
@@ -473,6 +545,7 @@ var (
 	ERR_WRITE_FILE_FAILED         = EC(600002, "write file failed")
 	ERR_BUILD_REGEX_FAILED        = EC(600003, "build regex failed")
 	ERR_BUILD_TEMPLATE_FAILED     = EC(600004, "build template failed")
+	ERR_EXECUTE_TASK_TIMED_OUT    = EC(600005, "execute task timed out")
 
 	// 610: exeute task (ssh command)
 	ERR_DOWNLOAD_FILE_FROM_REMOTE_BY_SSH_FAILED         = EC(610000, "download file from remote by ssh failed")
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package netperf
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+/*
+ * protocol: the client sends one byte mode after connected
+ *   ping:   client sends PING_SIZE bytes and server echoes them back
+ *   stream: client writes data until half-close, then server replies
+ *           the received bytes in 8 bytes (big endian)
+ */
+const (
+	MODE_PING   byte = 'p'
+	MODE_STREAM byte = 's'
+
+	PING_SIZE          = 64
+	STREAM_BUFFER_SIZE = 128 * 1024
+
+	DIAL_RETRY_DELAY = 200 * time.Millisecond
+)
+
+var (
+	DIAL_TIMEOUT = 5 * time.Second // variable for test
+
+	ErrUnknownMode     = errors.New("unknown netperf mode")
+	ErrInvalidPings    = errors.New("pings requires positive integer")
+	ErrInvalidDuration = errors.New("duration requires positive duration")
+)
+
+type (
+	Server struct {
+		listener net.Listener
+		wg       sync.WaitGroup
+	}
+
+	Result struct {
+		Target     string  `json:"target"`
+		LatencyAvg float64 `json:"latency_avg_ms"`
+		LatencyMax float64 `json:"latency_max_ms"`
+		Throughput float64 `json:"throughput_mbps"`
+		Error      string  `json:"error,omitempty"`
+	}
+)
+
+func NewServer(listen string) (*Server, error) {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{listener: listener}, nil
+}
+
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *Server) Close() error {
+	return s.listener.Close()
+}
+
+// Serve accepts connections until timeout or closed, the timeout prevents the server living forever
+func (s *Server) Serve(timeout time.Duration) error {
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() { s.listener.Close() })
+		defer timer.Stop()
+	}
+
+	for {
+		conn, err := s.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *Server) handle(conn net.Conn) error {
+	mode := make([]byte, 1)
+	if _, err := io.ReadFull(conn, mode); err != nil {
+		return err
+	}
+
+	switch mode[0] {
+	case MODE_PING:
+		buffer := make([]byte, PING_SIZE)
+		for {
+			if _, err := io.ReadFull(conn, buffer); err != nil {
+				return nil // client finished
+			} else if _, err := conn.Write(buffer); err != nil {
+				return err
+			}
+		}
+	case MODE_STREAM:
+		n, err := io.CopyBuffer(io.Discard, conn, make([]byte, STREAM_BUFFER_SIZE))
+		if err != nil {
+			return err
+		}
+		return binary.Write(conn, binary.BigEndian, uint64(n))
+	}
+	return ErrUnknownMode
+}
+
+// the server may be starting, so we retry until DIAL_TIMEOUT
+func dial(addr string, mode byte) (*net.TCPConn, error) {
+	deadline := time.Now().Add(DIAL_TIMEOUT)
+	for {
+		conn, err := net.DialTimeout("tcp", addr, DIAL_TIMEOUT)
+		if err == nil {
+			tcpConn := conn.(*net.TCPConn)
+			if _, err := tcpConn.Write([]byte{mode}); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return tcpConn, nil
+		} else if time.Now().After(deadline) {
+			return nil, err
+		}
+		time.Sleep(DIAL_RETRY_DELAY)
+	}
+}
+
+// Ping returns the average and max round-trip time in milliseconds
+func Ping(addr string, count int) (float64, float64, error) {
+	conn, err := dial(addr, MODE_PING)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+	conn.SetNoDelay(true)
+
+	var total, max time.Duration
+	buffer := make([]byte, PING_SIZE)
+	for i := 0; i < count; i++ {
+		start := time.Now()
+		if _, err := conn.Write(buffer); err != nil {
+			return 0, 0, err
+		} else if _, err := io.ReadFull(conn, buffer); err != nil {
+			return 0, 0, err
+		}
+		rtt := time.Since(start)
+		total += rtt
+		if rtt > max {
+			max = rtt
+		}
+	}
+
+	ms := func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	return ms(total) / float64(count), ms(max), nil
+}
+
+// Stream writes data for duration and returns the throughput in Mbps which received by server
+func Stream(addr string, duration time.Duration) (float64, error) {
+	conn, err := dial(addr, MODE_STREAM)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	buffer := make([]byte, STREAM_BUFFER_SIZE)
+	start := time.Now()
+	conn.SetWriteDeadline(start.Add(duration))
+	for {
+		if _, err := conn.Write(buffer); err != nil {
+			if errors.Is(err, net.ErrClosed) || !isTimeout(err) {
+				return 0, err
+			}
+			break
+		}
+	}
+	if err := conn.CloseWrite(); err != nil {
+		return 0, err
+	}
+
+	var received uint64
+	conn.SetReadDeadline(time.Now().Add(DIAL_TIMEOUT))
+	if err := binary.Read(conn, binary.BigEndian, &received); err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(start).Seconds()
+	return float64(received) * 8 / elapsed / 1e6, nil
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// CheckOptions rejects the options which make the measurement meaningless,
+// e.g. the average latency of zero pings is NaN
+func CheckOptions(pings int, duration time.Duration) error {
+	if pings <= 0 {
+		return ErrInvalidPings
+	} else if duration <= 0 {
+		return ErrInvalidDuration
+	}
+	return nil
+}
+
+// Run measures the latency and throughput from local to addr
+func Run(addr string, pings int, duration time.Duration) Result {
+	result := Result{Target: addr}
+	if err := CheckOptions(pings, duration); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	avg, max, err := Ping(addr, pings)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.LatencyAvg, result.LatencyMax = avg, max
+
+	throughput, err := Stream(addr, duration)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Throughput = throughput
+	return result
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package netperf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetperf(t *testing.T) {
+	assert := assert.New(t)
+
+	server, err := NewServer("127.0.0.1:0")
+	assert.NoError(err)
+	done := make(chan error)
+	go func() { done <- server.Serve(10 * time.Second) }()
+
+	result := Run(server.Addr(), 10, 200*time.Millisecond)
+	assert.Empty(result.Error)
+	assert.Greater(result.LatencyAvg, 0.0)
+	assert.GreaterOrEqual(result.LatencyMax, result.LatencyAvg)
+	assert.Greater(result.Throughput, 0.0)
+
+	server.Close()
+	assert.NoError(<-done)
+}
+
+func TestNetperfServerTimeout(t *testing.T) {
+	assert := assert.New(t)
+	origin := DIAL_TIMEOUT
+	defer func() { DIAL_TIMEOUT = origin }()
+	DIAL_TIMEOUT = 500 * time.Millisecond
+
+	server, err := NewServer("127.0.0.1:0")
+	assert.NoError(err)
+	assert.NoError(server.Serve(100 * time.Millisecond))
+
+	result := Run(server.Addr(), 1, time.Millisecond)
+	assert.NotEmpty(result.Error)
+}
+
+func TestNetperfInvalidOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(CheckOptions(1, time.Millisecond))
+	assert.ErrorIs(CheckOptions(0, time.Second), ErrInvalidPings)
+	assert.ErrorIs(CheckOptions(-1, time.Second), ErrInvalidPings)
+	assert.ErrorIs(CheckOptions(10, 0), ErrInvalidDuration)
+	assert.ErrorIs(CheckOptions(10, -time.Second), ErrInvalidDuration)
+
+	// rejected before connecting, no server is required
+	result := Run("127.0.0.1:1", 0, time.Second)
+	assert.Equal(ErrInvalidPings.Error(), result.Error)
+	assert.Zero(result.LatencyAvg)
+}
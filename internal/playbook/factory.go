@@ -33,6 +33,7 @@ const (
 	CHECK_SSH_CONNECT
 	CHECK_PERMISSION
 	CHECK_KERNEL_VERSION
+	GET_KERNEL_RELEASE
 	CHECK_KERNEL_MODULE
 	CHECK_PORT_IN_USE
 	CHECK_DESTINATION_REACHABLE
@@ -41,6 +42,7 @@ const (
 	GET_HOST_DATE
 	CHECK_HOST_DATE
 	CHECK_S3
+	CHECK_SYSTEM
 	CLEAN_PRECHECK_ENVIRONMENT
 
 	// common
@@ -64,12 +66,24 @@ const (
 	CREATE_META_TABLES
 	INIT_SERVIE_STATUS
 	GET_SERVICE_STATUS
+	GET_SERVICE_TOP
 	CLEAN_SERVICE
 	BACKUP_ETCD_DATA
 	CHECK_MDS_ADDRESS
 	CHECK_STORE_HEALTH
 	INIT_CLIENT_STATUS
 	GET_CLIENT_STATUS
+	COLLECT_HOST_INFO
+	COLLECT_SERVICE_INFO
+	LIST_CORE_FILES
+	FETCH_CORE_FILE
+	COLLECT_HOST_FACTS
+	PREPARE_HOST_DISKS
+	GET_HOST_DISKS_STATUS
+	TUNE_HOST
+	START_NETPERF_SERVER
+	RUN_NETPERF_CLIENT
+	STOP_NETPERF_SERVER
 
 	// dingodb
 	START_DINGODB_DOCUMENT
@@ -102,6 +116,7 @@ const (
 	GET_MONITOR_STATUS
 	CLEAN_MONITOR_SERVICE
 	SYNC_GRAFANA_DASHBOARD
+	EXPORT_GRAFANA_DASHBOARD
 
 	// fs
 	CHECK_CLIENT_S3
@@ -144,7 +159,13 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 		// only need to execute task once per host
 		switch step.Type {
 		case CHECK_SSH_CONNECT,
-			GET_HOST_DATE:
+			GET_KERNEL_RELEASE,
+			GET_HOST_DATE,
+			COLLECT_HOST_INFO,
+			TUNE_HOST,
+			START_NETPERF_SERVER,
+			RUN_NETPERF_CLIENT,
+			STOP_NETPERF_SERVER:
 			host := config.GetDC(i).GetHost()
 			if once[host] {
 				continue
@@ -157,6 +178,15 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 				continue
 			}
 			once[host+"_"+image] = true
+		case LIST_CORE_FILES: // services on the same host may share the core directory
+			dc := config.GetDC(i)
+			if len(dc.GetTargetCoreDir()) > 0 {
+				key := dc.GetHost() + "_" + dc.GetTargetCoreDir()
+				if once[key] {
+					continue
+				}
+				once[key] = true
+			}
 		case SYNC_MONITOR_ORIGIN_CONFIG:
 			if config.GetMC(i).GetRole() != configure.ROLE_MONITOR_SYNC {
 				continue
@@ -165,7 +195,7 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			if config.GetMC(i).GetRole() == configure.ROLE_MONITOR_SYNC {
 				continue
 			}
-		case SYNC_GRAFANA_DASHBOARD:
+		case SYNC_GRAFANA_DASHBOARD, EXPORT_GRAFANA_DASHBOARD:
 			if config.GetMC(i).GetRole() != configure.ROLE_GRAFANA {
 				continue
 			}
@@ -184,6 +214,8 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewCheckPermissionTask(dingocli, config.GetDC(i))
 		case CHECK_KERNEL_VERSION:
 			t, err = checker.NewCheckKernelVersionTask(dingocli, config.GetDC(i))
+		case GET_KERNEL_RELEASE:
+			t, err = checker.NewGetKernelReleaseTask(dingocli, config.GetDC(i))
 		case CHECK_KERNEL_MODULE:
 			t, err = checker.NewCheckKernelModuleTask(dingocli, config.GetCC(i))
 		case CHECK_PORT_IN_USE:
@@ -209,6 +241,8 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = checker.NewCheckDate(dingocli, nil)
 		case CHECK_S3:
 			t, err = checker.NewCheckS3Task(dingocli, config.GetDC(i))
+		case CHECK_SYSTEM:
+			t, err = checker.NewRunCheckersTask(dingocli, config.GetDC(i))
 		case CHECK_MDS_ADDRESS:
 			t, err = checker.NewCheckMdsAddressTask(dingocli, config.GetCC(i))
 		case CHECK_STORE_HEALTH:
@@ -254,12 +288,36 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewInitServiceStatusTask(dingocli, config.GetDC(i))
 		case GET_SERVICE_STATUS:
 			t, err = comm.NewGetServiceStatusTask(dingocli, config.GetDC(i))
+		case GET_SERVICE_TOP:
+			t, err = comm.NewGetServiceTopTask(dingocli, config.GetDC(i))
 		case CLEAN_SERVICE:
 			t, err = comm.NewCleanServiceTask(dingocli, config.GetDC(i))
 		case INIT_CLIENT_STATUS:
 			t, err = comm.NewInitClientStatusTask(dingocli, config.GetAny(i))
 		case GET_CLIENT_STATUS:
 			t, err = comm.NewGetClientStatusTask(dingocli, config.GetAny(i))
+		case COLLECT_HOST_INFO:
+			t, err = comm.NewCollectHostInfoTask(dingocli, config.GetDC(i))
+		case COLLECT_SERVICE_INFO:
+			t, err = comm.NewCollectServiceInfoTask(dingocli, config.GetDC(i))
+		case LIST_CORE_FILES:
+			t, err = comm.NewListCoreFilesTask(dingocli, config.GetDC(i))
+		case FETCH_CORE_FILE:
+			t, err = comm.NewFetchCoreFileTask(dingocli, config.GetDC(i))
+		case COLLECT_HOST_FACTS:
+			t, err = comm.NewCollectHostFactsTask(dingocli, config.GetHC(i))
+		case PREPARE_HOST_DISKS:
+			t, err = comm.NewPrepareDisksTask(dingocli, config.GetHC(i))
+		case GET_HOST_DISKS_STATUS:
+			t, err = comm.NewGetDisksStatusTask(dingocli, config.GetHC(i))
+		case TUNE_HOST:
+			t, err = comm.NewTuneHostTask(dingocli, config.GetDC(i))
+		case START_NETPERF_SERVER:
+			t, err = comm.NewStartNetperfServerTask(dingocli, config.GetDC(i))
+		case RUN_NETPERF_CLIENT:
+			t, err = comm.NewRunNetperfClientTask(dingocli, config.GetDC(i))
+		case STOP_NETPERF_SERVER:
+			t, err = comm.NewStopNetperfServerTask(dingocli, config.GetDC(i))
 		// fs
 		case CHECK_CLIENT_S3:
 			t, err = checker.NewClientS3ConfigureTask(dingocli, config.GetCC(i))
@@ -291,6 +349,8 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewSyncJavaOptsTask(dingocli, config.GetDC(i))
 		case SYNC_GRAFANA_DASHBOARD:
 			t, err = monitor.NewSyncGrafanaDashboardTask(dingocli, config.GetMC(i))
+		case EXPORT_GRAFANA_DASHBOARD:
+			t, err = monitor.NewExportGrafanaDashboardTask(dingocli, config.GetMC(i))
 
 		default:
 			return nil, errno.ERR_UNKNOWN_TASK_TYPE.
@@ -17,8 +17,15 @@
 package playbook
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/tasks"
+	"github.com/fatih/color"
 )
 
 /*
@@ -47,10 +54,17 @@ type (
 		tasks.ExecOptions
 	}
 
+	// StepResult is the result of one task in playbook step
+	StepResult struct {
+		Type int
+		tasks.Result
+	}
+
 	Playbook struct {
 		dingocli  *cli.DingoCli
 		steps     []*PlaybookStep
 		postSteps []*PlaybookStep
+		results   []StepResult
 	}
 
 	ExecOptions = tasks.ExecOptions
@@ -71,16 +85,23 @@ func (p *Playbook) AddPostStep(s *PlaybookStep) {
 	p.postSteps = append(p.postSteps, s)
 }
 
-func (p *Playbook) run(steps []*PlaybookStep) error {
+func (p *Playbook) run(ctx context.Context, steps []*PlaybookStep) ([]tasks.Summary, error) {
+	summaries := []tasks.Summary{}
 	for i, step := range steps {
 		tasks, err := p.createTasks(step)
 		if err != nil {
-			return err
+			return summaries, err
 		}
 
-		err = tasks.Execute(step.ExecOptions)
-		if err != nil && step.Type != CHECK_PORT_IN_USE {
-			return err
+		err = tasks.Execute(ctx, step.ExecOptions)
+		summaries = append(summaries, tasks.Summary())
+		for _, result := range tasks.Results() {
+			p.results = append(p.results, StepResult{Type: step.Type, Result: result})
+		}
+		if ctx.Err() == context.Canceled {
+			return summaries, errno.ERR_CANCEL_OPERATION
+		} else if err != nil && step.Type != CHECK_PORT_IN_USE && !step.SkipError {
+			return summaries, err
 		}
 
 		isLast := (i == len(steps)-1)
@@ -88,17 +109,66 @@ func (p *Playbook) run(steps []*PlaybookStep) error {
 			p.dingocli.WriteOutln("")
 		}
 	}
-	return nil
+	return summaries, nil
 }
 
-func (p *Playbook) Run() error {
-	defer func() {
-		if len(p.postSteps) == 0 {
-			return
+// Results returns the results of all executed tasks, including the post steps
+func (p *Playbook) Results() []StepResult {
+	return p.results
+}
+
+/*
+ * Interrupted, 2/4 steps executed:
+ *   + Pull Image        total=3 success=3 skip=0 cancel=0 error=0
+ *   + Create Container  total=3 success=1 skip=0 cancel=2 error=0
+ */
+func (p *Playbook) displaySummary(summaries []tasks.Summary) {
+	p.dingocli.WriteOutln("")
+	p.dingocli.WriteOutln(color.YellowString("Interrupted, %d/%d steps executed:",
+		len(summaries), len(p.steps)))
+	width := 0
+	for _, summary := range summaries {
+		width = max(width, len(summary.Name))
+	}
+	for _, summary := range summaries {
+		if summary.Total == 0 {
+			continue
 		}
+		p.dingocli.WriteOutln("  + %-*s  total=%d success=%d skip=%d cancel=%d error=%d",
+			width, summary.Name, summary.Total, summary.Succeeded,
+			summary.Skipped, summary.Canceled, summary.Failed)
+	}
+}
+
+/*
+ * SIGINT/SIGTERM will cancel the running playbook:
+ *   (1) stop scheduling the remaining tasks and steps
+ *   (2) cancel the in-flight commands (SSH sessions)
+ *   (3) run the post steps for cleanup
+ *   (4) print the summary of executed steps
+ */
+func (p *Playbook) Run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	summaries, err := p.run(ctx, p.steps)
+	stop() // press Ctrl-C again will terminate the process immediately
+	if len(p.postSteps) > 0 {
 		p.dingocli.WriteOutln("")
-		p.run(p.postSteps)
-	}()
+		p.run(context.Background(), p.postSteps)
+	}
+	if ctx.Err() != nil {
+		p.displaySummary(summaries)
+	}
+	return err
+}
 
-	return p.run(p.steps)
+// RunContext runs the playbook without handling signals, the caller cancels it by ctx,
+// e.g. the command which runs playbook periodically and stops on its own signal handler.
+func (p *Playbook) RunContext(ctx context.Context) error {
+	_, err := p.run(ctx, p.steps)
+	if len(p.postSteps) > 0 {
+		p.run(context.Background(), p.postSteps)
+	}
+	return err
 }
@@ -17,8 +17,11 @@ package rpc
 import (
 	"context"
 
+	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/output"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
 	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 )
 
@@ -58,12 +61,33 @@ type UnlockCacheMemberRpc struct {
 	cacheGroupClient mds.MDSServiceClient
 }
 
+type CreateCacheGroupRpc struct {
+	Info             *Rpc
+	Request          *mds.CreateGroupRequest
+	cacheGroupClient mds.MDSServiceClient
+}
+
+type DeleteCacheGroupRpc struct {
+	Info             *Rpc
+	Request          *mds.DeleteGroupRequest
+	cacheGroupClient mds.MDSServiceClient
+}
+
+type JoinCacheMemberRpc struct {
+	Info             *Rpc
+	Request          *mds.JoinCacheGroupRequest
+	cacheGroupClient mds.MDSServiceClient
+}
+
 var _ RpcFunc = (*ListCacheGroupRpc)(nil)    // check interface
 var _ RpcFunc = (*ListCacheMemberRpc)(nil)   // check interface
 var _ RpcFunc = (*ReWeightMemberRpc)(nil)    // check interface
 var _ RpcFunc = (*LeaveCacheMemberRpc)(nil)  // check interface
 var _ RpcFunc = (*DeleteCacheMemberRpc)(nil) // check interface
 var _ RpcFunc = (*UnlockCacheMemberRpc)(nil) // check interface
+var _ RpcFunc = (*CreateCacheGroupRpc)(nil)  // check interface
+var _ RpcFunc = (*DeleteCacheGroupRpc)(nil)  // check interface
+var _ RpcFunc = (*JoinCacheMemberRpc)(nil)   // check interface
 
 func (listCacheGroup *ListCacheGroupRpc) NewRpcClient(cc grpc.ClientConnInterface) {
 	listCacheGroup.cacheGroupClient = mds.NewMDSServiceClient(cc)
@@ -124,3 +148,63 @@ func (unlockMember *UnlockCacheMemberRpc) Stub_Func(ctx context.Context) (interf
 	output.ShowRpcData(unlockMember.Request, response, unlockMember.Info.RpcDataShow)
 	return response, err
 }
+
+func (createGroup *CreateCacheGroupRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	createGroup.cacheGroupClient = mds.NewMDSServiceClient(cc)
+}
+
+func (createGroup *CreateCacheGroupRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := createGroup.cacheGroupClient.CreateGroup(ctx, createGroup.Request)
+	output.ShowRpcData(createGroup.Request, response, createGroup.Info.RpcDataShow)
+	return response, err
+}
+
+func (deleteGroup *DeleteCacheGroupRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	deleteGroup.cacheGroupClient = mds.NewMDSServiceClient(cc)
+}
+
+func (deleteGroup *DeleteCacheGroupRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := deleteGroup.cacheGroupClient.DeleteGroup(ctx, deleteGroup.Request)
+	output.ShowRpcData(deleteGroup.Request, response, deleteGroup.Info.RpcDataShow)
+	return response, err
+}
+
+func (joinMember *JoinCacheMemberRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	joinMember.cacheGroupClient = mds.NewMDSServiceClient(cc)
+}
+
+func (joinMember *JoinCacheMemberRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := joinMember.cacheGroupClient.JoinCacheGroup(ctx, joinMember.Request)
+	output.ShowRpcData(joinMember.Request, response, joinMember.Info.RpcDataShow)
+	return response, err
+}
+
+// list cache members, list members of all groups if group is empty
+func ListCacheMembers(cmd *cobra.Command, group string) ([]*mds.CacheGroupMember, error) {
+	// new prc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ListMembers")
+	if err != nil {
+		return nil, err
+	}
+	// set request info
+	request := &mds.ListMembersRequest{}
+	if len(group) != 0 {
+		request.GroupName = &group
+	}
+	listRpc := &ListCacheMemberRpc{
+		Info:    mdsRpc,
+		Request: request,
+	}
+
+	// get rpc result
+	response, rpcError := GetRpcResponse(listRpc.Info, listRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.ListMembersResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetMembers(), nil
+}
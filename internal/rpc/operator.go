@@ -164,6 +164,93 @@ func GetFsInfo(cmd *cobra.Command, fsId uint32, fsName string) (*mds.FsInfo, err
 	return fsInfo, nil
 }
 
+// get filesystem quota, include capacity and usage
+func GetFsQuota(cmd *cobra.Command, fsId uint32, epoch uint64) (*mds.GetFsQuotaResponse, error) {
+	// new prc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "GetFsQuota")
+	if err != nil {
+		return nil, err
+	}
+	// set request info
+	getFsQuotaRpc := &GetFsQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.GetFsQuotaRequest{
+			Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
+			FsId:    fsId,
+		},
+	}
+
+	// get rpc result
+	response, rpcError := GetRpcResponse(getFsQuotaRpc.Info, getFsQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.GetFsQuotaResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result, nil
+}
+
+// get filesystem io stats
+func GetFsStats(cmd *cobra.Command, fsName string, epoch uint64) (*mds.GetFsStatsResponse, error) {
+	// new prc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "GetFsStats")
+	if err != nil {
+		return nil, err
+	}
+	// set request info
+	getFsStatsRpc := &GetFsStatsRpc{
+		Info: mdsRpc,
+		Request: &mds.GetFsStatsRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsName:  fsName,
+		},
+	}
+
+	// get rpc result
+	response, rpcError := GetRpcResponse(getFsStatsRpc.Info, getFsStatsRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.GetFsStatsResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result, nil
+}
+
+// load all directory quotas of filesystem, the fs mds router must be initialized
+func LoadDirQuotas(cmd *cobra.Command, fsId uint32, epoch uint64) (*mds.LoadDirQuotasResponse, error) {
+	// new prc
+	mdsRpc, err := CreateNewMdsRpc(cmd, "LoadDirQuotas")
+	if err != nil {
+		return nil, err
+	}
+	// set request info
+	listQuotaRpc := &ListDirQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.LoadDirQuotasRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+		},
+	}
+
+	// get rpc result
+	response, rpcError := GetRpcResponse(listQuotaRpc.Info, listQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.LoadDirQuotasResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result, nil
+}
+
 // GetDentry
 func GetDentry(cmd *cobra.Command, fsId uint32, parentId uint64, name string, epoch uint64) (*mds.Dentry, error) {
 	endpoint := GetEndPoint(parentId)
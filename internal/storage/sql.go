@@ -323,6 +323,9 @@ var (
 
 	// delete item
 	DeleteAnyItem = `DELETE from any WHERE id = ?`
+
+	// replace item
+	ReplaceAnyItem = `REPLACE INTO any(id, data) VALUES(?, ?)`
 )
 
 var (
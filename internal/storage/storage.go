@@ -428,6 +428,8 @@ func (s *Storage) GetAuditLog(id int64) ([]AuditLog, error) {
 // any item prefix
 const (
 	PREFIX_CLIENT_CONFIG = 0x01
+	PREFIX_HOST_FACTS    = 0x02
+	PREFIX_TUNE_SNAPSHOT = 0x03
 )
 
 func (s *Storage) realId(prefix int, id string) string {
@@ -465,6 +467,51 @@ func (s *Storage) DeleteClientConfig(id string) error {
 	return s.write(DeleteAnyItem, id)
 }
 
+// host facts
+func (s *Storage) SetHostFacts(host, data string) error {
+	id := s.realId(PREFIX_HOST_FACTS, host)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) getAnyItems(id string) ([]Any, error) {
+	result, err := s.db.Query(SelectAnyItem, id)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	items := []Any{}
+	var item Any
+	for result.Next() {
+		err = result.Scan(&item.Id, &item.Data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Storage) GetHostFacts(host string) ([]Any, error) {
+	return s.getAnyItems(s.realId(PREFIX_HOST_FACTS, host))
+}
+
+// tune snapshot
+func (s *Storage) SetTuneSnapshot(host, data string) error {
+	id := s.realId(PREFIX_TUNE_SNAPSHOT, host)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetTuneSnapshot(host string) ([]Any, error) {
+	return s.getAnyItems(s.realId(PREFIX_TUNE_SNAPSHOT, host))
+}
+
+func (s *Storage) DeleteTuneSnapshot(host string) error {
+	id := s.realId(PREFIX_TUNE_SNAPSHOT, host)
+	return s.write(DeleteAnyItem, id)
+}
+
 func (s *Storage) GetMonitor(clusterId int) (Monitor, error) {
 	monitor := Monitor{
 		ClusterId: clusterId,
@@ -17,27 +17,51 @@
 package context
 
 import (
+	"context"
+
 	"github.com/dingodb/dingocli/pkg/module"
 )
 
 type Context struct {
+	ctx       context.Context
 	sshClient *module.SSHClient
 	module    *module.Module
 	register  *Register
 }
 
-func NewContext(sshClient *module.SSHClient) (*Context, error) {
+func NewContext(ctx context.Context, sshClient *module.SSHClient) (*Context, error) {
 	return &Context{
+		ctx:       ctx,
 		sshClient: sshClient,
-		module:    module.NewModule(sshClient),
+		module:    module.NewModule(ctx, sshClient),
 		register:  NewRegister(),
 	}, nil
 }
 
+// Detach returns a context which shares the SSH client and register,
+// but it will not be canceled, it's used for cleanup steps.
+func (ctx *Context) Detach() *Context {
+	detached := context.WithoutCancel(ctx.ctx)
+	return &Context{
+		ctx:       detached,
+		sshClient: ctx.sshClient,
+		module:    module.NewModule(detached, ctx.sshClient),
+		register:  ctx.register,
+	}
+}
+
+// Close gives the SSH connection back to pool, the connection is shared by
+// other tasks on the same host (and the detached cleanup steps), so only
+// the reference of this borrower is released even if the task is interrupted.
 func (ctx *Context) Close() {
-	if ctx.sshClient != nil {
-		ctx.sshClient.Client().Close()
+	if ctx.sshClient == nil {
+		return
 	}
+	module.GlobalSSHPool().Put(ctx.sshClient)
+}
+
+func (ctx *Context) Context() context.Context {
+	return ctx.ctx
 }
 
 func (ctx *Context) SSHClient() *module.SSHClient {
@@ -17,7 +17,7 @@
 package scripts
 
 import (
-	_ "embed"
+	"embed"
 )
 
 var (
@@ -49,9 +49,16 @@ var (
 	//go:embed shell/sync_prometheus.sh
 	SYNC_PROMETHEUS string
 
-	// Grafana dashboard
-	//go:embed shell/server_metric_zh.json
-	GRAFANA_SERVER_METRIC string
+	// Prometheus alerting
+	//go:embed shell/sync_alerting.sh
+	SYNC_ALERTING string
+
+	//go:embed shell/dingofs_alert_rules.yml
+	DINGOFS_ALERT_RULES string
+
+	// Grafana dashboards, layout: dashboards/<kind|common>/<language>/<name>.json
+	//go:embed dashboards
+	DASHBOARDS embed.FS
 
 	// Extract /etc/hosts mapping
 	//go:embed shell/extract_hosts.sh
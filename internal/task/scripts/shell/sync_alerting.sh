@@ -0,0 +1,25 @@
+#!/usr/bin/env bash
+# usage: sync_alerting.sh <prometheus_config_path> <rule_files> [alertmanager_addr]
+PROMETHEUS_CONFIG_PATH=$1
+RULE_FILES=$2
+ALERTMANAGER_ADDR=$3
+
+# remove rule_files and alerting sections which synced before
+awk '
+/^[^ #]/ { skip = ($0 ~ /^(rule_files|alerting):/) }
+!skip { print }
+' ${PROMETHEUS_CONFIG_PATH} > ${PROMETHEUS_CONFIG_PATH}.tmp || exit 1
+
+# sections are placed at the head, the scrape configs are appended to the tail
+{
+  echo "rule_files:"
+  echo "  - '${RULE_FILES}'"
+  if [ -n "${ALERTMANAGER_ADDR}" ]; then
+    echo "alerting:"
+    echo "  alertmanagers:"
+    echo "    - static_configs:"
+    echo "        - targets: ['${ALERTMANAGER_ADDR}']"
+  fi
+  echo
+  cat ${PROMETHEUS_CONFIG_PATH}.tmp
+} > ${PROMETHEUS_CONFIG_PATH} && rm -f ${PROMETHEUS_CONFIG_PATH}.tmp
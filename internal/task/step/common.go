@@ -17,6 +17,8 @@
 package step
 
 import (
+	stdctx "context"
+
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/task/context"
 	"github.com/dingodb/dingocli/internal/utils"
@@ -47,14 +49,21 @@ func PostHandle(Success *bool, Out *string, out string, err error, ec *errno.Err
 		return nil
 	}
 
+	// execute failed
+	if ec == nil {
+		ec = errno.ERR_UNKNOWN
+	}
+
 	// execute timed out
 	if _, ok := err.(*module.TimeoutError); ok {
 		return errno.ERR_EXECUTE_COMMAND_TIMED_OUT.S(ec.GetDescription())
 	}
 
-	// execute failed
-	if ec == nil {
-		ec = errno.ERR_UNKNOWN
+	// execute interrupted: reached the deadline of playbook step or canceled by user
+	if err == stdctx.DeadlineExceeded {
+		return errno.ERR_EXECUTE_TASK_TIMED_OUT.S(ec.GetDescription())
+	} else if err == stdctx.Canceled {
+		return errno.ERR_CANCEL_OPERATION
 	}
 	if len(out) > 0 {
 		return ec.S(out)
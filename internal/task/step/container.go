@@ -38,6 +38,7 @@ type (
 	Volume struct { // bind mount a volume
 		HostPath      string
 		ContainerPath string
+		Options       string // e.g. ro,rslave
 	}
 
 	CreateContainer struct {
@@ -144,6 +145,8 @@ type (
 
 	ContainerLogs struct {
 		ContainerId string
+		Tail        int    // only output the last N lines, 0 means all
+		Since       string // e.g. 2026-01-02T15:04:05 or 30m
 		Out         *string
 		Success     *bool
 		module.ExecOptions
@@ -221,7 +224,11 @@ func (s *CreateContainer) Execute(ctx *context.Context) error {
 		cli.AddOption("--ulimit %s", ulimit)
 	}
 	for _, volume := range s.Volumes {
-		cli.AddOption("--volume %s:%s", volume.HostPath, volume.ContainerPath)
+		if len(volume.Options) > 0 {
+			cli.AddOption("--volume %s:%s:%s", volume.HostPath, volume.ContainerPath, volume.Options)
+		} else {
+			cli.AddOption("--volume %s:%s", volume.HostPath, volume.ContainerPath)
+		}
 	}
 
 	out, err := cli.Execute(s.ExecOptions)
@@ -318,6 +325,12 @@ func (s *InspectContainer) Execute(ctx *context.Context) error {
 
 func (s *ContainerLogs) Execute(ctx *context.Context) error {
 	cli := ctx.Module().DockerCli().ContainerLogs(s.ContainerId)
+	if s.Tail > 0 {
+		cli.AddOption("--tail %d", s.Tail)
+	}
+	if len(s.Since) > 0 {
+		cli.AddOption("--since %s", s.Since)
+	}
 	out, err := cli.Execute(s.ExecOptions)
 	return PostHandle(s.Success, s.Out, out, err, errno.ERR_GET_CONTAINER_LOGS_FAILED.FD("(%s logs ID)", s.ExecWithEngine))
 }
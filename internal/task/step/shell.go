@@ -167,10 +167,14 @@ type (
 
 	Curl struct {
 		Url      string
+		Method   string
+		Headers  []string
+		Data     string
 		Form     string
 		Insecure bool
 		Output   string
 		Silent   bool
+		Fail     bool
 		Success  *bool
 		Out      *string
 		module.ExecOptions
@@ -466,6 +470,15 @@ func (s *Ping) Execute(ctx *context.Context) error {
 
 func (s *Curl) Execute(ctx *context.Context) error {
 	cmd := ctx.Module().Shell().Curl(s.Url)
+	if len(s.Method) > 0 {
+		cmd.AddOption("--request %s", s.Method)
+	}
+	for _, header := range s.Headers {
+		cmd.AddOption("--header '%s'", header)
+	}
+	if len(s.Data) > 0 {
+		cmd.AddOption("--data %s", s.Data)
+	}
 	if len(s.Form) > 0 {
 		cmd.AddOption("--form %s", s.Form)
 	}
@@ -478,6 +491,9 @@ func (s *Curl) Execute(ctx *context.Context) error {
 	if s.Silent {
 		cmd.AddOption("--silent")
 	}
+	if s.Fail {
+		cmd.AddOption("--fail")
+	}
 
 	out, err := cmd.Execute(s.ExecOptions)
 	return PostHandle(s.Success, s.Out, out, err, errno.ERR_TRANSFERRING_DATA_FROM_OR_TO_SERVER_FAILED)
@@ -599,11 +615,24 @@ func (s *Scp) Execute(ctx *context.Context) error {
 		return errno.ERR_WRITE_FILE_FAILED.E(err)
 	}
 
+	// local scp can't use the jump host's own key or the password,
+	// upload it by SFTP instead
 	config := ctx.SSHClient().Config()
+	if len(config.ProxyJump) > 0 || config.Password != nil || config.Passphrase != nil {
+		err := ctx.Module().File().Upload(localPath, s.RemotePath)
+		if err != nil {
+			return errno.ERR_SECURE_COPY_FILE_TO_REMOTE_FAILED.E(err)
+		}
+		return nil
+	}
+
 	cmd := ctx.Module().Shell().Scp(localPath, config.User, config.Host, s.RemotePath)
 	cmd.AddOption("-P %d", config.Port)
 	if !config.ForwardAgent {
 		cmd.AddOption("-i %s", config.PrivateKeyPath)
+		if len(config.CertificatePath) > 0 {
+			cmd.AddOption("-o CertificateFile=%s", config.CertificatePath)
+		}
 	}
 
 	options := s.ExecOptions
@@ -125,6 +125,23 @@ func checkDate(dingocli *cli.DingoCli) step.LambdaType {
 	}
 }
 
+// GetHostDateSkews returns the seconds which each host ahead of the earliest host
+func GetHostDateSkews(dingocli *cli.DingoCli) map[string]int64 {
+	m := newIfNil(dingocli)
+	min := int64(0)
+	for _, t := range m {
+		if min == 0 || t.time < min {
+			min = t.time
+		}
+	}
+
+	skews := map[string]int64{}
+	for host, t := range m {
+		skews[host] = t.time - min
+	}
+	return skews
+}
+
 func NewCheckDate(dingocli *cli.DingoCli, c interface{}) (*task.Task, error) {
 	t := task.NewTask("Check Host Date <date>", "", nil)
 	t.AddStep(&step.Lambda{
@@ -30,6 +30,7 @@ import (
 	"github.com/dingodb/dingocli/internal/task/context"
 	"github.com/dingodb/dingocli/internal/task/step"
 	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
 )
 
 const (
@@ -47,6 +48,29 @@ func calcKernelVersion(version string) int {
 	return num
 }
 
+func saveKernelRelease(dingocli *cli.DingoCli, dc *topology.DeployConfig, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		dingocli.MemStorage().TX(func(kv *utils.SafeMap) error {
+			m := map[string]string{}
+			if v := kv.Get(comm.KEY_ALL_HOST_KERNEL); v != nil {
+				m = v.(map[string]string)
+			}
+			m[dc.GetHost()] = strings.TrimSpace(*out)
+			kv.Set(comm.KEY_ALL_HOST_KERNEL, m)
+			return nil
+		})
+		return nil
+	}
+}
+
+// GetHostKernelReleases returns the kernel release (uname -r) of each checked host
+func GetHostKernelReleases(dingocli *cli.DingoCli) map[string]string {
+	if v := dingocli.MemStorage().Get(comm.KEY_ALL_HOST_KERNEL); v != nil {
+		return v.(map[string]string)
+	}
+	return map[string]string{}
+}
+
 func checkKernelVersion(out *string, dc *topology.DeployConfig) step.LambdaType {
 	return func(ctx *context.Context) error {
 		if !dc.GetEnableRenameAt2() {
@@ -114,6 +138,29 @@ func NewCheckKernelVersionTask(dingocli *cli.DingoCli, dc *topology.DeployConfig
 	return t, nil
 }
 
+// NewGetKernelReleaseTask only records the kernel release of host, it never fails the precheck
+func NewGetKernelReleaseTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	subname := fmt.Sprintf("host=%s", dc.GetHost())
+	t := task.NewTask("Get Kernel Release <kernel>", subname, hc.GetSSHConfig())
+
+	var out string
+	t.AddStep(&step.UnixName{
+		KernelRelease: true,
+		Out:           &out,
+		ExecOptions:   dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: saveKernelRelease(dingocli, dc, &out),
+	})
+
+	return t, nil
+}
+
 func NewCheckKernelModuleTask(dingocli *cli.DingoCli, cc *configure.ClientConfig) (*task.Task, error) {
 	host := dingocli.MemStorage().Get(comm.KEY_CLIENT_HOST).(string)
 	hc, err := dingocli.GetHost(host)
@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	CHECK_SEVERITY_WARN = "warn"
+	CHECK_SEVERITY_FAIL = "fail"
+
+	CHECK_STATUS_PASS = "pass"
+	CHECK_STATUS_WARN = "warn"
+	CHECK_STATUS_FAIL = "fail"
+
+	// host scope checker only executed once per host
+	CHECK_SCOPE_HOST    = "host"
+	CHECK_SCOPE_SERVICE = "service"
+)
+
+type (
+	CheckEnv struct {
+		DC          *topology.DeployConfig
+		DCs         []*topology.DeployConfig // all deploy configs of cluster
+		ExecOptions module.ExecOptions
+	}
+
+	// CheckFunc returns the measured value and error if check failed
+	CheckFunc func(ctx *context.Context, env *CheckEnv) (string, error)
+
+	Checker struct {
+		Name        string
+		Description string
+		Roles       []string // empty means all roles
+		Severity    string
+		Scope       string
+		Enable      func(dc *topology.DeployConfig) bool // optional
+		Check       CheckFunc
+	}
+
+	CheckResult struct {
+		Host     string `json:"host"`
+		Role     string `json:"role,omitempty"`
+		Check    string `json:"check"`
+		Severity string `json:"severity"`
+		Status   string `json:"status"`
+		Value    string `json:"value,omitempty"`
+		Code     int    `json:"code,omitempty"`
+		Error    string `json:"error,omitempty"`
+		Clue     string `json:"clue,omitempty"`
+	}
+
+	step2RunCheckers struct {
+		env        *CheckEnv
+		checkers   []*Checker
+		memStorage *utils.SafeMap
+	}
+)
+
+var registry = []*Checker{}
+
+// Register adds checker into registry, the name must be unique
+func Register(c *Checker) error {
+	if GetChecker(c.Name) != nil {
+		return errno.ERR_CHECKER_ALREADY_REGISTERED.F("checker: %s", c.Name)
+	}
+	if len(c.Scope) == 0 {
+		c.Scope = CHECK_SCOPE_SERVICE
+	}
+	registry = append(registry, c)
+	return nil
+}
+
+func GetChecker(name string) *Checker {
+	for _, c := range registry {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func GetCheckers() []*Checker {
+	return append([]*Checker{}, registry...)
+}
+
+func GetCheckerNames() []string {
+	names := []string{}
+	for _, c := range registry {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func (c *Checker) Applies(dc *topology.DeployConfig) bool {
+	if len(c.Roles) > 0 && !utils.Contains(c.Roles, dc.GetRole()) {
+		return false
+	} else if c.Enable != nil && !c.Enable(dc) {
+		return false
+	}
+	return true
+}
+
+func newCheckResult(dc *topology.DeployConfig, c *Checker, value string, err error) CheckResult {
+	result := CheckResult{
+		Host:     dc.GetHost(),
+		Check:    c.Name,
+		Severity: c.Severity,
+		Status:   CHECK_STATUS_PASS,
+		Value:    value,
+	}
+	if c.Scope == CHECK_SCOPE_SERVICE {
+		result.Role = dc.GetRole()
+	}
+	if err == nil {
+		return result
+	}
+
+	result.Status = utils.Choose(c.Severity == CHECK_SEVERITY_FAIL, CHECK_STATUS_FAIL, CHECK_STATUS_WARN)
+	if ec, ok := err.(*errno.ErrorCode); ok {
+		result.Code = ec.GetCode()
+		result.Error = ec.GetDescription()
+		result.Clue = ec.GetClue()
+	} else {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// claim returns false if the host scope checker already executed by other service
+func (s *step2RunCheckers) claim(c *Checker) bool {
+	if c.Scope != CHECK_SCOPE_HOST {
+		return true
+	}
+
+	claimed := false
+	key := fmt.Sprintf("%s:%s", s.env.DC.GetHost(), c.Name)
+	s.memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]bool{}
+		if v := kv.Get(comm.KEY_CHECK_CLAIMED_CHECKERS); v != nil {
+			m = v.(map[string]bool)
+		}
+		if !m[key] {
+			m[key], claimed = true, true
+			kv.Set(comm.KEY_CHECK_CLAIMED_CHECKERS, m)
+		}
+		return nil
+	})
+	return claimed
+}
+
+/*
+ * all checkers are executed even if some of them failed,
+ * the task fails only if any checker with fail severity failed.
+ */
+func (s *step2RunCheckers) Execute(ctx *context.Context) error {
+	results := []CheckResult{}
+	var failed error
+	for _, c := range s.checkers {
+		if !s.claim(c) {
+			continue
+		}
+
+		value, err := c.Check(ctx, s.env)
+		result := newCheckResult(s.env.DC, c, value, err)
+		results = append(results, result)
+		if err == nil {
+			continue
+		}
+
+		log.Warn("Checker failed",
+			log.Field("host", s.env.DC.GetHost()),
+			log.Field("role", s.env.DC.GetRole()),
+			log.Field("checker", c.Name),
+			log.Field("value", value),
+			log.Field("error", err))
+		if result.Status == CHECK_STATUS_FAIL && failed == nil {
+			failed = err
+		}
+	}
+
+	s.memStorage.TX(func(kv *utils.SafeMap) error {
+		all := []CheckResult{}
+		if v := kv.Get(comm.KEY_ALL_CHECK_RESULTS); v != nil {
+			all = v.([]CheckResult)
+		}
+		kv.Set(comm.KEY_ALL_CHECK_RESULTS, append(all, results...))
+		return nil
+	})
+	return failed
+}
+
+// SortCheckResults sorts results by host, check and role
+func SortCheckResults(results []CheckResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Host != results[j].Host {
+			return results[i].Host < results[j].Host
+		} else if results[i].Check != results[j].Check {
+			return results[i].Check < results[j].Check
+		}
+		return results[i].Role < results[j].Role
+	})
+}
+
+func NewRunCheckersTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	selected := dingocli.MemStorage().Get(comm.KEY_CHECK_SELECTED_CHECKERS).([]string)
+	checkers := []*Checker{}
+	names := []string{}
+	for _, name := range selected {
+		c := GetChecker(name)
+		if c != nil && c.Applies(dc) {
+			checkers = append(checkers, c)
+			names = append(names, name)
+		}
+	}
+	if len(checkers) == 0 {
+		return nil, nil
+	}
+
+	subname := fmt.Sprintf("host=%s role=%s checks=%s", dc.GetHost(), dc.GetRole(), strings.Join(names, ","))
+	t := task.NewTask("Check System <system>", subname, hc.GetSSHConfig())
+	t.AddStep(&step2RunCheckers{
+		env: &CheckEnv{
+			DC:          dc,
+			DCs:         dingocli.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS).([]*topology.DeployConfig),
+			ExecOptions: dingocli.ExecOptions(),
+		},
+		checkers:   checkers,
+		memStorage: dingocli.MemStorage(),
+	})
+	return t, nil
+}
@@ -0,0 +1,382 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/configure/os"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	CHECKER_ULIMIT     = "ulimit"
+	CHECKER_FD         = "fd"
+	CHECKER_MEMORY     = "memory"
+	CHECKER_DISK_SPACE = "disk-space"
+	CHECKER_FS_TYPE    = "fs-type"
+	CHECKER_FUSE       = "fuse"
+	CHECKER_DOCKER     = "docker"
+	CHECKER_NTP        = "ntp"
+	CHECKER_RDMA       = "rdma"
+
+	MIN_OPEN_FILES          = 65536
+	MIN_USER_PROCESSES      = 65536
+	MIN_FILE_MAX            = 1048576
+	MIN_DATA_DIR_FREE_SPACE = 10 * humanize.GiByte
+	MIN_DOCKER_VERSION      = "20.10.0"
+	MAX_NTP_OFFSET_MS       = 100.0
+
+	ULIMIT_UNLIMITED = "unlimited"
+	ULIMIT_NOFILE    = "nofile"
+	ULIMIT_NPROC     = "nproc"
+	DEVICE_FUSE      = "/dev/fuse"
+	DIR_INFINIBAND   = "/sys/class/infiniband"
+
+	COMMAND_NEAREST_DF      = `bash -c 'd=%s; while [ ! -e "$d" ]; do d=$(dirname "$d"); done; df -PT -B1 "$d"'`
+	COMMAND_CHRONY_TRACKING = "chronyc tracking"
+	COMMAND_NTPQ_PEERS      = "ntpq -pn"
+	COMMAND_TIMEDATECTL     = "timedatectl show -p NTPSynchronized --value"
+)
+
+var (
+	// the memory required by each service
+	MIN_MEMORY_BY_ROLE = map[string]uint64{
+		topology.ROLE_FS_MDS:           4 * humanize.GiByte,
+		topology.ROLE_COORDINATOR:      4 * humanize.GiByte,
+		topology.ROLE_STORE:            8 * humanize.GiByte,
+		topology.ROLE_DINGODB_DOCUMENT: 8 * humanize.GiByte,
+		topology.ROLE_DINGODB_INDEX:    8 * humanize.GiByte,
+		topology.ROLE_DINGODB_DISKANN:  8 * humanize.GiByte,
+		topology.ROLE_DINGODB_EXECUTOR: 4 * humanize.GiByte,
+	}
+	DEFAULT_MIN_MEMORY = uint64(1 * humanize.GiByte)
+
+	RECOMMENDED_FILESYSTEMS = []string{"ext4", "xfs"}
+
+	REGEX_MEM_AVAILABLE     = regexp.MustCompile(`(?m)^MemAvailable:\s+(\d+)\s+kB`)
+	REGEX_DOCKER_VERSION    = regexp.MustCompile(`Server Version:\s*(\d+\.\d+\.\d+)`)
+	REGEX_CHRONY_SYSTEM     = regexp.MustCompile(`System time\s*:\s*([\d.]+) seconds (fast|slow)`)
+	REGEX_CHRONY_NOT_SYNCED = regexp.MustCompile(`Leap status\s*:\s*Not synchronised`)
+)
+
+func init() {
+	for _, c := range []*Checker{
+		{
+			Name:        CHECKER_ULIMIT,
+			Description: "open files and max user processes limits",
+			Severity:    CHECK_SEVERITY_WARN,
+			Scope:       CHECK_SCOPE_HOST,
+			Check:       checkUlimit,
+		},
+		{
+			Name:        CHECKER_FD,
+			Description: "system-wide file descriptors limit",
+			Severity:    CHECK_SEVERITY_WARN,
+			Scope:       CHECK_SCOPE_HOST,
+			Check:       checkFileMax,
+		},
+		{
+			Name:        CHECKER_MEMORY,
+			Description: "available memory for all services on host",
+			Severity:    CHECK_SEVERITY_WARN,
+			Scope:       CHECK_SCOPE_HOST,
+			Check:       checkMemory,
+		},
+		{
+			Name:        CHECKER_DISK_SPACE,
+			Description: "free space of data directory",
+			Severity:    CHECK_SEVERITY_FAIL,
+			Enable:      hasDataDir,
+			Check:       checkDiskSpace,
+		},
+		{
+			Name:        CHECKER_FS_TYPE,
+			Description: "filesystem type of data directory",
+			Severity:    CHECK_SEVERITY_WARN,
+			Enable:      hasDataDir,
+			Check:       checkFilesystemType,
+		},
+		// dingofs clients are usually colocated with the services
+		{
+			Name:        CHECKER_FUSE,
+			Description: "fuse device for dingofs client",
+			Severity:    CHECK_SEVERITY_WARN,
+			Scope:       CHECK_SCOPE_HOST,
+			Enable:      func(dc *topology.DeployConfig) bool { return dc.GetKind() == topology.KIND_DINGOFS },
+			Check:       checkFuseDevice,
+		},
+		{
+			Name:        CHECKER_DOCKER,
+			Description: "docker engine version",
+			Severity:    CHECK_SEVERITY_FAIL,
+			Scope:       CHECK_SCOPE_HOST,
+			Check:       checkDockerVersion,
+		},
+		{
+			Name:        CHECKER_NTP,
+			Description: "clock offset to NTP server",
+			Severity:    CHECK_SEVERITY_WARN,
+			Scope:       CHECK_SCOPE_HOST,
+			Check:       checkNTPOffset,
+		},
+		{
+			Name:        CHECKER_RDMA,
+			Description: "RDMA devices while enable_rdma is set",
+			Severity:    CHECK_SEVERITY_FAIL,
+			Enable:      func(dc *topology.DeployConfig) bool { return dc.GetEnableRDMA() },
+			Check:       checkRDMADevice,
+		},
+	} {
+		if err := Register(c); err != nil {
+			log.Error("Register checker", log.Field("Error", err))
+		}
+	}
+}
+
+func hasDataDir(dc *topology.DeployConfig) bool {
+	return len(dc.GetDataDir()) > 0
+}
+
+func execute(ctx *context.Context, env *CheckEnv, command string) (string, error) {
+	out, err := ctx.Module().Shell().Command(command).Execute(env.ExecOptions)
+	return strings.TrimSpace(out), err
+}
+
+// the limits of SSH session may differ from the configured ones which services started with
+func checkUlimit(ctx *context.Context, env *CheckEnv) (string, error) {
+	out, err := execute(ctx, env, os.ReadLimitsCommand())
+	if err != nil {
+		return "", errno.ERR_UNKNOWN.S(out)
+	}
+
+	limits := os.ParseLimits(out)
+	nofile, nproc := os.GetLimit(limits, ULIMIT_NOFILE), os.GetLimit(limits, ULIMIT_NPROC)
+	value := fmt.Sprintf("nofile=%s nproc=%s", nofile, nproc)
+	below := func(limit string, min int) bool {
+		n, err := strconv.Atoi(limit)
+		return limit != ULIMIT_UNLIMITED && (err != nil || n < min)
+	}
+	if below(nofile, MIN_OPEN_FILES) {
+		return value, errno.ERR_OPEN_FILES_LIMIT_TOO_LOW.F("nofile=%s, require>=%d", nofile, MIN_OPEN_FILES)
+	} else if below(nproc, MIN_USER_PROCESSES) {
+		return value, errno.ERR_MAX_USER_PROCESSES_TOO_LOW.F("nproc=%s, require>=%d", nproc, MIN_USER_PROCESSES)
+	}
+	return value, nil
+}
+
+func checkFileMax(ctx *context.Context, env *CheckEnv) (string, error) {
+	out, err := ctx.Module().Shell().Cat("/proc/sys/fs/file-max").Execute(env.ExecOptions)
+	if err != nil {
+		return "", errno.ERR_CONCATENATE_FILE_FAILED.S(out)
+	}
+	n, err := strconv.ParseUint(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return out, errno.ERR_UNKNOWN.F("unrecognized fs.file-max: %s", out)
+	}
+
+	value := fmt.Sprintf("file-max=%d", n)
+	if n < MIN_FILE_MAX {
+		return value, errno.ERR_FILE_MAX_TOO_LOW.F("fs.file-max=%d, require>=%d", n, MIN_FILE_MAX)
+	}
+	return value, nil
+}
+
+// sum of memory required by all services on the same host
+func requiredMemory(host string, dcs []*topology.DeployConfig) uint64 {
+	var total uint64
+	for _, dc := range dcs {
+		if dc.GetHost() != host || dc.GetRole() == topology.ROLE_FS_MDS_CLI {
+			continue
+		} else if n, ok := MIN_MEMORY_BY_ROLE[dc.GetRole()]; ok {
+			total += n
+		} else {
+			total += DEFAULT_MIN_MEMORY
+		}
+	}
+	return total
+}
+
+func checkMemory(ctx *context.Context, env *CheckEnv) (string, error) {
+	out, err := ctx.Module().Shell().Cat("/proc/meminfo").Execute(env.ExecOptions)
+	if err != nil {
+		return "", errno.ERR_CONCATENATE_FILE_FAILED.S(out)
+	}
+	mu := REGEX_MEM_AVAILABLE.FindStringSubmatch(out)
+	if len(mu) == 0 {
+		return "", errno.ERR_UNKNOWN.S("MemAvailable not found in /proc/meminfo")
+	}
+
+	kb, _ := strconv.ParseUint(mu[1], 10, 64)
+	available := kb * 1024
+	require := requiredMemory(env.DC.GetHost(), env.DCs)
+	value := fmt.Sprintf("available=%s require=%s", humanize.IBytes(available), humanize.IBytes(require))
+	if available < require {
+		return value, errno.ERR_INSUFFICIENT_MEMORY.S(value)
+	}
+	return value, nil
+}
+
+// returns filesystem type and available bytes of the nearest existing directory
+func getDataDirFilesystem(ctx *context.Context, env *CheckEnv) (string, uint64, error) {
+	out, err := execute(ctx, env, fmt.Sprintf(COMMAND_NEAREST_DF, env.DC.GetDataDir()))
+	if err != nil {
+		return "", 0, errno.ERR_UNKNOWN.S(out)
+	}
+
+	// Filesystem Type 1-blocks Used Available Capacity Mounted on
+	lines := strings.Split(out, "\n")
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(lines) < 2 || len(fields) < 6 {
+		return "", 0, errno.ERR_UNKNOWN.F("unrecognized df output: %s", out)
+	}
+	avail, err := strconv.ParseUint(fields[4], 10, 64)
+	if err != nil {
+		return "", 0, errno.ERR_UNKNOWN.F("unrecognized df output: %s", out)
+	}
+	return fields[1], avail, nil
+}
+
+func checkDiskSpace(ctx *context.Context, env *CheckEnv) (string, error) {
+	_, avail, err := getDataDirFilesystem(ctx, env)
+	if err != nil {
+		return "", err
+	}
+
+	value := fmt.Sprintf("free=%s", humanize.IBytes(avail))
+	if avail < MIN_DATA_DIR_FREE_SPACE {
+		return value, errno.ERR_INSUFFICIENT_DISK_SPACE.F("data_dir=%s, free=%s, require>=%s",
+			env.DC.GetDataDir(), humanize.IBytes(avail), humanize.IBytes(MIN_DATA_DIR_FREE_SPACE))
+	}
+	return value, nil
+}
+
+func checkFilesystemType(ctx *context.Context, env *CheckEnv) (string, error) {
+	fstype, _, err := getDataDirFilesystem(ctx, env)
+	if err != nil {
+		return "", err
+	} else if !utils.Contains(RECOMMENDED_FILESYSTEMS, fstype) {
+		return fstype, errno.ERR_UNRECOMMENDED_FILESYSTEM.F("data_dir=%s, filesystem=%s",
+			env.DC.GetDataDir(), fstype)
+	}
+	return fstype, nil
+}
+
+func checkFuseDevice(ctx *context.Context, env *CheckEnv) (string, error) {
+	_, err := ctx.Module().Shell().Test(DEVICE_FUSE).AddOption("-c").Execute(env.ExecOptions)
+	if err != nil {
+		return "absent", errno.ERR_FUSE_DEVICE_NOT_FOUND.F("host=%s", env.DC.GetHost())
+	}
+	return "present", nil
+}
+
+func checkDockerVersion(ctx *context.Context, env *CheckEnv) (string, error) {
+	out, err := ctx.Module().DockerCli().DockerInfo().Execute(env.ExecOptions)
+	if err != nil {
+		return "", errno.ERR_DOCKER_DAEMON_IS_NOT_RUNNING.S(out)
+	}
+
+	mu := REGEX_DOCKER_VERSION.FindStringSubmatch(out)
+	if len(mu) == 0 { // e.g. podman
+		return "unknown", nil
+	} else if compareVersion(mu[1], MIN_DOCKER_VERSION) < 0 {
+		return mu[1], errno.ERR_DOCKER_VERSION_TOO_OLD.F("version=%s, require>=%s", mu[1], MIN_DOCKER_VERSION)
+	}
+	return mu[1], nil
+}
+
+// compareVersion compares dotted versions (e.g. 20.10.0) numerically item by item
+func compareVersion(v1, v2 string) int {
+	items1, items2 := strings.Split(v1, "."), strings.Split(v2, ".")
+	for i := 0; i < len(items1) || i < len(items2); i++ {
+		n1, n2 := 0, 0
+		if i < len(items1) {
+			n1, _ = strconv.Atoi(items1[i])
+		}
+		if i < len(items2) {
+			n2, _ = strconv.Atoi(items2[i])
+		}
+		if n1 < n2 {
+			return -1
+		} else if n1 > n2 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// returns clock offset in milliseconds and whether it's synchronized
+func parseChronyTracking(out string) (float64, bool) {
+	mu := REGEX_CHRONY_SYSTEM.FindStringSubmatch(out)
+	if len(mu) == 0 || REGEX_CHRONY_NOT_SYNCED.MatchString(out) {
+		return 0, false
+	}
+	offset, _ := strconv.ParseFloat(mu[1], 64)
+	return offset * 1000, true
+}
+
+// the line of selected peer starts with '*': remote refid st t when poll reach delay offset jitter
+func parseNtpqPeers(out string) (float64, bool) {
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 10 || !strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+		offset, err := strconv.ParseFloat(fields[8], 64)
+		return offset, err == nil
+	}
+	return 0, false
+}
+
+func checkNTPOffset(ctx *context.Context, env *CheckEnv) (string, error) {
+	var offset float64
+	synced := false
+	if out, err := execute(ctx, env, COMMAND_CHRONY_TRACKING); err == nil {
+		offset, synced = parseChronyTracking(out)
+	} else if out, err := execute(ctx, env, COMMAND_NTPQ_PEERS); err == nil {
+		offset, synced = parseNtpqPeers(out)
+	} else if out, err := execute(ctx, env, COMMAND_TIMEDATECTL); err == nil && out == "yes" {
+		return "synchronized", nil // systemd-timesyncd doesn't expose the offset
+	}
+
+	if !synced {
+		return "unsynchronized", errno.ERR_NTP_NOT_SYNCHRONIZED.F("host=%s", env.DC.GetHost())
+	}
+	value := fmt.Sprintf("offset=%.3fms", offset)
+	if math.Abs(offset) > MAX_NTP_OFFSET_MS {
+		return value, errno.ERR_NTP_OFFSET_TOO_LARGE.F("offset=%.3fms, require<=%.0fms", offset, MAX_NTP_OFFSET_MS)
+	}
+	return value, nil
+}
+
+func checkRDMADevice(ctx *context.Context, env *CheckEnv) (string, error) {
+	out, err := ctx.Module().Shell().List(DIR_INFINIBAND).Execute(env.ExecOptions)
+	devices := strings.Fields(out)
+	if err != nil || len(devices) == 0 {
+		return "none", errno.ERR_RDMA_DEVICE_NOT_FOUND.F("host=%s", env.DC.GetHost())
+	}
+	return strings.Join(devices, ","), nil
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package checker
+
+import (
+	"testing"
+)
+
+const (
+	CHRONY_TRACKING_SYNCED = `Reference ID    : A9FEA97B (169.254.169.123)
+Stratum         : 4
+Ref time (UTC)  : Sat Oct 17 08:15:03 2026
+System time     : 0.000012345 seconds slow of NTP time
+Last offset     : -0.000004521 seconds
+RMS offset      : 0.000012210 seconds
+Frequency       : 8.352 ppm fast
+Leap status     : Normal`
+
+	CHRONY_TRACKING_FAST = `Reference ID    : C0A80001 (192.168.0.1)
+System time     : 0.250000000 seconds fast of NTP time
+Leap status     : Normal`
+
+	CHRONY_TRACKING_NOT_SYNCED = `Reference ID    : 00000000 ()
+Stratum         : 0
+System time     : 0.000000000 seconds fast of NTP time
+Leap status     : Not synchronised`
+
+	NTPQ_PEERS = `     remote           refid      st t when poll reach   delay   offset  jitter
+==============================================================================
++10.0.0.2        10.0.0.1         3 u   12   64  377    0.151   -0.043   0.021
+*10.0.0.1        .GPS.            1 u   33   64  377    0.204    1.526   0.047
+ 10.0.0.3        .INIT.          16 u    -   64    0    0.000    0.000   0.000`
+
+	NTPQ_PEERS_NO_SELECTED = `     remote           refid      st t when poll reach   delay   offset  jitter
+==============================================================================
+ 10.0.0.3        .INIT.          16 u    -   64    0    0.000    0.000   0.000`
+)
+
+/*
+ * TestParseChronyTracking, run: go test ./internal/task/task/checker -run ^TestParseChronyTracking$
+ */
+func TestParseChronyTracking(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		offset float64
+		synced bool
+	}{
+		{"synced", CHRONY_TRACKING_SYNCED, 0.012345, true},
+		{"fast", CHRONY_TRACKING_FAST, 250, true},
+		{"not synchronised", CHRONY_TRACKING_NOT_SYNCED, 0, false},
+		{"empty", "", 0, false},
+		{"unrecognized", "506 Cannot talk to daemon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, synced := parseChronyTracking(tt.out)
+			if synced != tt.synced {
+				t.Errorf("expect synced=%v, got %v", tt.synced, synced)
+			}
+			if diff := offset - tt.offset; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("expect offset=%f, got %f", tt.offset, offset)
+			}
+		})
+	}
+}
+
+/*
+ * TestParseNtpqPeers, run: go test ./internal/task/task/checker -run ^TestParseNtpqPeers$
+ */
+func TestParseNtpqPeers(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		offset float64
+		synced bool
+	}{
+		{"selected peer", NTPQ_PEERS, 1.526, true},
+		{"no selected peer", NTPQ_PEERS_NO_SELECTED, 0, false},
+		{"empty", "", 0, false},
+		{"invalid offset", "*10.0.0.1 .GPS. 1 u 33 64 377 0.204 - 0.047", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, synced := parseNtpqPeers(tt.out)
+			if synced != tt.synced {
+				t.Errorf("expect synced=%v, got %v", tt.synced, synced)
+			}
+			if offset != tt.offset {
+				t.Errorf("expect offset=%f, got %f", tt.offset, offset)
+			}
+		})
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		expect int
+	}{
+		{"20.10.0", "20.10.0", 0},
+		{"20.10", "20.10.0", 0},
+		{"19.03.15", "20.10.0", -1},
+		{"20.10.24", "20.10.0", 1},
+		{"24.0.7", "20.10.0", 1},
+		{"20.9.1000", "20.10.0", -1},
+	}
+
+	for _, tt := range tests {
+		if n := compareVersion(tt.v1, tt.v2); n != tt.expect {
+			t.Errorf("compareVersion(%s, %s): expect %d, got %d", tt.v1, tt.v2, tt.expect, n)
+		}
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	n := len(GetCheckers())
+	if err := Register(&Checker{Name: CHECKER_ULIMIT}); err == nil {
+		t.Errorf("expect error for duplicate checker %s", CHECKER_ULIMIT)
+	}
+	if len(GetCheckers()) != n {
+		t.Errorf("expect %d checkers, got %d", n, len(GetCheckers()))
+	}
+}
@@ -0,0 +1,433 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	// <modify time> <size> <path>
+	COMMAND_FIND_CORE_FILES = `find %s -maxdepth 1 -type f -printf "%%T@ %%s %%p\n"`
+	COMMAND_FILE_TYPE       = "file -b %s"
+	COMMAND_CAT_CORE_FILE   = "cat %s 2>/dev/null" // the stderr is streamed into core file too
+	SIGNATURE_CORE_FILE     = "core file"
+	CORE_BINARY_UNKNOWN     = "-"
+	CORE_OWNER_UNKNOWN      = "-"
+	FORMAT_CONTAINER_IMAGE  = "{{.Config.Image}}"
+)
+
+var (
+	// ELF 64-bit LSB core file, x86-64, ..., from '/dingofs/mds/sbin/dingo-mds --confPath=...', ...,
+	// execfn: '/dingofs/mds/sbin/dingo-mds', platform: 'x86_64'
+	REGEX_CORE_EXECFN = regexp.MustCompile(`execfn: '([^']+)'`)
+	REGEX_CORE_FROM   = regexp.MustCompile(`from '([^' ]+)`)
+	REGEX_CORE_CMD    = regexp.MustCompile(`from '([^']+)'`)
+)
+
+type (
+	CoreFile struct {
+		Id          string
+		Host        string
+		Role        string
+		ServiceId   string
+		ContainerId string // core file is inside container if not empty
+		Image       string // image of the service container, empty if the owner is unknown
+		Path        string
+		Time        time.Time
+		Size        int64
+		Binary      string // binary path in container
+	}
+
+	// the service which may own the core files in the directory
+	coreService struct {
+		role        string
+		prefix      string
+		serviceId   string
+		containerId string
+		image       string
+	}
+
+	step2ListCoreFiles struct {
+		host        string
+		services    []*coreService
+		dir         string
+		inContainer bool
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+
+	step2StreamCoreFile struct {
+		core        CoreFile
+		localPath   string
+		execOptions module.ExecOptions
+	}
+
+	step2CopyBinaryFromImage struct {
+		image       string
+		binary      string
+		destPath    string
+		execOptions module.ExecOptions
+	}
+)
+
+// HasBinary returns true if the binary and the image which it copied from are both known
+func (c CoreFile) HasBinary() bool {
+	return c.Binary != CORE_BINARY_UNKNOWN && len(c.Image) > 0
+}
+
+func GetCoreFileId(host, path string) string {
+	return utils.MD5Sum(fmt.Sprintf("%s:%s", host, path))[:12]
+}
+
+func setCoreFile(memStorage *utils.SafeMap, core CoreFile) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]CoreFile{}
+		v := kv.Get(comm.KEY_ALL_CORE_FILES)
+		if v != nil {
+			m = v.(map[string]CoreFile)
+		}
+		m[core.Id] = core
+		kv.Set(comm.KEY_ALL_CORE_FILES, m)
+		return nil
+	})
+}
+
+func parseCoreBinary(out string) string {
+	if mu := REGEX_CORE_EXECFN.FindStringSubmatch(out); len(mu) > 0 {
+		return mu[1]
+	} else if mu := REGEX_CORE_FROM.FindStringSubmatch(out); len(mu) > 0 {
+		return mu[1]
+	}
+	return CORE_BINARY_UNKNOWN
+}
+
+// matchCoreService returns the service which the core file belongs to, it matches
+// the service prefix against the binary and command line of the crashed process.
+// if several services matched and they use the same image, the binary is still known
+// but the service is not, nil is returned if the image can't be told.
+func matchCoreService(services []*coreService, file string) *coreService {
+	text := parseCoreBinary(file)
+	if mu := REGEX_CORE_CMD.FindStringSubmatch(file); len(mu) > 0 {
+		text = text + " " + mu[1]
+	}
+
+	best, matched := 0, []*coreService{}
+	for _, service := range services {
+		prefix := strings.TrimSuffix(service.prefix, "/")
+		if len(prefix) == 0 || !strings.Contains(text, prefix+"/") {
+			continue
+		} else if len(prefix) > best {
+			best, matched = len(prefix), []*coreService{}
+		}
+		if len(prefix) == best {
+			matched = append(matched, service)
+		}
+	}
+	if len(matched) == 0 {
+		matched = services
+	}
+	if len(matched) == 1 {
+		return matched[0]
+	}
+
+	owner := &coreService{role: matched[0].role, image: matched[0].image}
+	for _, service := range matched[1:] {
+		if service.image != owner.image {
+			return nil
+		} else if service.role != owner.role {
+			owner.role = ""
+		}
+	}
+	return owner
+}
+
+func (s *step2ListCoreFiles) execute(ctx *context.Context, command string) (string, error) {
+	if s.inContainer {
+		return ctx.Module().DockerCli().ContainerExec(s.services[0].containerId, command).Execute(s.execOptions)
+	}
+	return ctx.Module().Shell().Command(command).Execute(s.execOptions)
+}
+
+// the image of running container may differ from the configured one, e.g. config changed but not upgraded
+func (s *step2ListCoreFiles) resolveImages(ctx *context.Context) {
+	for _, service := range s.services {
+		if service.containerId == comm.CLEANED_CONTAINER_ID || len(service.containerId) == 0 {
+			continue
+		}
+		cli := ctx.Module().DockerCli().InspectContainer(service.containerId)
+		cli.AddOption("--format=%s", FORMAT_CONTAINER_IMAGE)
+		out, err := cli.Execute(s.execOptions)
+		if err == nil && len(strings.TrimSpace(out)) > 0 {
+			service.image = strings.TrimSpace(out)
+		}
+	}
+}
+
+func (s *step2ListCoreFiles) Execute(ctx *context.Context) error {
+	out, err := s.execute(ctx, fmt.Sprintf(COMMAND_FIND_CORE_FILES, s.dir))
+	if err != nil {
+		return errno.ERR_LIST_DIRECTORY_CONTENTS_FAILED.S(out)
+	}
+
+	s.resolveImages(ctx)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		mtime, err1 := strconv.ParseFloat(fields[0], 64)
+		size, err2 := strconv.ParseInt(fields[1], 10, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		// the binary is unknown if 'file' is not installed, but we still list it
+		binary, owner := CORE_BINARY_UNKNOWN, s.services[0]
+		out, err := s.execute(ctx, fmt.Sprintf(COMMAND_FILE_TYPE, fields[2]))
+		if err == nil {
+			if !strings.Contains(out, SIGNATURE_CORE_FILE) {
+				continue
+			}
+			binary = parseCoreBinary(out)
+			owner = matchCoreService(s.services, out)
+		} else if len(s.services) > 1 {
+			owner = nil
+		}
+
+		core := CoreFile{
+			Id:     GetCoreFileId(s.host, fields[2]),
+			Host:   s.host,
+			Role:   CORE_OWNER_UNKNOWN,
+			Path:   fields[2],
+			Time:   time.Unix(int64(mtime), 0),
+			Size:   size,
+			Binary: binary,
+		}
+		if owner != nil {
+			core.Role = utils.Choose(len(owner.role) > 0, owner.role, CORE_OWNER_UNKNOWN)
+			core.ServiceId = owner.serviceId
+			core.Image = owner.image
+		}
+		if s.inContainer {
+			core.ContainerId = s.services[0].containerId
+		}
+		setCoreFile(s.memStorage, core)
+	}
+	return nil
+}
+
+// stream core file from its original path into local file, the core file may be
+// too large to be copied on host, the partial file is removed if streaming failed.
+func (s *step2StreamCoreFile) Execute(ctx *context.Context) (err error) {
+	file, err := os.Create(s.localPath)
+	if err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	defer func() {
+		file.Close()
+		if err != nil {
+			os.Remove(s.localPath)
+		}
+	}()
+
+	command := fmt.Sprintf(COMMAND_CAT_CORE_FILE, s.core.Path)
+	if len(s.core.ContainerId) > 0 {
+		err = ctx.Module().DockerCli().ContainerExec(s.core.ContainerId, command).Stream(s.execOptions, file)
+	} else {
+		err = ctx.Module().Shell().Command(command).Stream(s.execOptions, file)
+	}
+	if err != nil {
+		return errno.ERR_DOWNLOAD_FILE_FROM_REMOTE_BY_SSH_FAILED.E(err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	} else if info.Size() != s.core.Size {
+		err = errno.ERR_DOWNLOAD_FILE_FROM_REMOTE_BY_SSH_FAILED.
+			F("core file %s: expect %d bytes, got %d bytes", s.core.Path, s.core.Size, info.Size())
+		return err
+	}
+	return nil
+}
+
+// copy binary out of a temporary container which created from the service image
+func (s *step2CopyBinaryFromImage) Execute(ctx *context.Context) error {
+	out, err := ctx.Module().DockerCli().CreateContainer(s.image, "").Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_CREATE_CONTAINER_FAILED.S(out)
+	}
+	containerId := strings.TrimSpace(out)
+	defer ctx.Module().DockerCli().RemoveContainer(containerId).Execute(s.execOptions)
+
+	out, err = ctx.Module().DockerCli().
+		CopyFromContainer(containerId, s.binary, s.destPath, false).
+		Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_COPY_FROM_CONTAINER_FAILED.S(out)
+	}
+	return nil
+}
+
+/*
+ * the core files are in the target_core_dir of host if it's specified,
+ * otherwise they are in the source_core_dir of container.
+ * services on the same host may share the target_core_dir, so the owner
+ * of each core file is matched from all services which use the directory.
+ */
+func NewListCoreFilesTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	dir, inContainer := dc.GetTargetCoreDir(), false
+	if len(dir) == 0 {
+		dir, inContainer = dc.GetSourceCoreDir(), true
+	}
+	if len(dir) == 0 {
+		return nil, nil
+	} else if inContainer && containerId == comm.CLEANED_CONTAINER_ID {
+		return nil, nil
+	}
+
+	newService := func(dc *topology.DeployConfig, serviceId, containerId string) *coreService {
+		return &coreService{
+			role:        dc.GetRole(),
+			prefix:      dc.GetPrefix(),
+			serviceId:   serviceId,
+			containerId: containerId,
+			image:       dc.GetContainerImage(), // overridden by the image of container if it exists
+		}
+	}
+	services := []*coreService{newService(dc, serviceId, containerId)}
+	if v := dingocli.MemStorage().Get(comm.KEY_ALL_DEPLOY_CONFIGS); v != nil && !inContainer {
+		for _, other := range v.([]*topology.DeployConfig) {
+			if other.GetId() == dc.GetId() || other.GetHost() != dc.GetHost() ||
+				other.GetTargetCoreDir() != dir {
+				continue
+			}
+			serviceId := dingocli.GetServiceId(other.GetId())
+			containerId, err := dingocli.GetContainerId(serviceId)
+			if err != nil {
+				return nil, err
+			}
+			services = append(services, newService(other, serviceId, containerId))
+		}
+	}
+
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("List Core Files", subname, hc.GetSSHConfig())
+	t.AddStep(&step2ListCoreFiles{
+		host:        dc.GetHost(),
+		services:    services,
+		dir:         dir,
+		inContainer: inContainer,
+		memStorage:  dingocli.MemStorage(),
+		execOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+/*
+ * fetch core file and its binary into local directory:
+ *   (1) stream core file from its original path, no copy on host
+ *   (2) copy binary out of image into a temporary directory of host
+ *   (3) make it readable for SSH user and download it by SFTP
+ *   (4) remove the temporary directory
+ */
+func NewFetchCoreFileTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	core := dingocli.MemStorage().Get(comm.KEY_FETCH_CORE_FILE).(CoreFile)
+	localDir := dingocli.MemStorage().Get(comm.KEY_FETCH_CORE_DIR).(string)
+	hc, err := dingocli.GetHost(core.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	subname := fmt.Sprintf("host=%s role=%s core=%s", core.Host, core.Role, core.Id)
+	t := task.NewTask("Fetch Core File", subname, hc.GetSSHConfig())
+
+	// (1) stream core file
+	options := dingocli.ExecOptions()
+	t.AddStep(&step2StreamCoreFile{
+		core:        core,
+		localPath:   filepath.Join(localDir, path.Base(core.Path)),
+		execOptions: options,
+	})
+	if !core.HasBinary() {
+		return t, nil
+	}
+
+	// (2) copy binary into temporary directory
+	remoteDir := fmt.Sprintf("%s/dingo-core-%s", step.TEMP_DIR, core.Id)
+	remoteBinary := path.Join(remoteDir, path.Base(core.Binary))
+	t.AddStep(&step.CreateDirectory{
+		Paths:       []string{remoteDir},
+		ExecOptions: options,
+	})
+	t.AddStep(&step2CopyBinaryFromImage{
+		image:       core.Image,
+		binary:      core.Binary,
+		destPath:    remoteBinary,
+		execOptions: options,
+	})
+
+	// (3) make it readable and download
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("chmod -R a+rX %s", remoteDir),
+		ExecOptions: options,
+	})
+	t.AddStep(&step.DownloadFile{
+		RemotePath:  remoteBinary,
+		LocalPath:   filepath.Join(localDir, path.Base(core.Binary)),
+		ExecOptions: options,
+	})
+
+	// (4) clean up, even if the download failed
+	t.AddPostStep(&step.RemoveFile{
+		Files:       []string{remoteDir},
+		ExecOptions: options,
+	})
+
+	return t, nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+)
+
+func coreFileType(cmdline, execfn string) string {
+	return "ELF 64-bit LSB core file, x86-64, version 1 (SYSV), SVR4-style, from '" + cmdline +
+		"', real uid: 0, effective uid: 0, execfn: '" + execfn + "', platform: 'x86_64'"
+}
+
+/*
+ * TestMatchCoreService, run: go test ./internal/task/task/common -run ^TestMatchCoreService$
+ */
+func TestMatchCoreService(t *testing.T) {
+	mds := &coreService{role: "mds", prefix: "/dingofs/mds", serviceId: "s1", image: "dingofs:v1"}
+	cache := &coreService{role: "cache", prefix: "/dingofs/cache", serviceId: "s2", image: "dingofs:v2"}
+	cache2 := &coreService{role: "cache", prefix: "/dingofs/cache", serviceId: "s3", image: "dingofs:v2"}
+	coordinator := &coreService{role: "coordinator", prefix: "/opt/dingo-store", serviceId: "s4", image: "store:v1"}
+	store := &coreService{role: "store", prefix: "/opt/dingo-store", serviceId: "s5", image: "store:v1"}
+	store2 := &coreService{role: "store", prefix: "/opt/dingo-store", serviceId: "s6", image: "store:v2"}
+
+	tests := []struct {
+		name      string
+		services  []*coreService
+		file      string
+		role      string // empty means the owner is nil
+		serviceId string
+		image     string
+	}{
+		{
+			"match by execfn",
+			[]*coreService{mds, cache},
+			coreFileType("/dingofs/cache/sbin/dingo-cache --flagfile=/dingofs/cache/conf/cache.conf", "/dingofs/cache/sbin/dingo-cache"),
+			"cache", "s2", "dingofs:v2",
+		},
+		{
+			"match by command line",
+			[]*coreService{mds, cache},
+			"ELF 64-bit LSB core file, x86-64, from 'dingo-mds --conf=/dingofs/mds/conf/mds.conf'",
+			"mds", "s1", "dingofs:v1",
+		},
+		{
+			"single service",
+			[]*coreService{mds},
+			"ELF 64-bit LSB core file, x86-64",
+			"mds", "s1", "dingofs:v1",
+		},
+		{
+			"same role and image",
+			[]*coreService{mds, cache, cache2},
+			coreFileType("/dingofs/cache/sbin/dingo-cache", "/dingofs/cache/sbin/dingo-cache"),
+			"cache", "", "dingofs:v2",
+		},
+		{
+			"same image but different roles",
+			[]*coreService{coordinator, store},
+			coreFileType("/opt/dingo-store/build/bin/dingodb_server", "/opt/dingo-store/build/bin/dingodb_server"),
+			"-", "", "store:v1",
+		},
+		{
+			"different images",
+			[]*coreService{coordinator, store2},
+			coreFileType("/opt/dingo-store/build/bin/dingodb_server", "/opt/dingo-store/build/bin/dingodb_server"),
+			"", "", "",
+		},
+		{
+			"no service matched",
+			[]*coreService{mds, cache},
+			coreFileType("/usr/bin/python3", "/usr/bin/python3"),
+			"", "", "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := matchCoreService(tt.services, tt.file)
+			if len(tt.role) == 0 {
+				if owner != nil {
+					t.Fatalf("expect no owner, got %+v", owner)
+				}
+				return
+			} else if owner == nil {
+				t.Fatalf("expect owner role=%s, got nil", tt.role)
+			}
+
+			role := owner.role
+			if len(role) == 0 {
+				role = CORE_OWNER_UNKNOWN
+			}
+			if role != tt.role || owner.serviceId != tt.serviceId || owner.image != tt.image {
+				t.Errorf("expect role=%s serviceId=%s image=%s, got role=%s serviceId=%s image=%s",
+					tt.role, tt.serviceId, tt.image, role, owner.serviceId, owner.image)
+			}
+		})
+	}
+}
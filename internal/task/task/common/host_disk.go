@@ -0,0 +1,424 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package common
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	FSTAB_FILE        = "/etc/fstab"
+	FSTAB_BACKUP_FILE = "/etc/fstab.dingo.bak"
+
+	DISK_STATUS_READY             = "ready"
+	DISK_STATUS_DEVICE_NOT_FOUND  = "device not found"
+	DISK_STATUS_NOT_FORMATTED     = "not formatted"
+	DISK_STATUS_FS_MISMATCH       = "filesystem mismatch"
+	DISK_STATUS_NOT_MOUNTED       = "not mounted"
+	DISK_STATUS_MOUNTED_ELSEWHERE = "mounted elsewhere"
+	DISK_STATUS_NO_FSTAB_ENTRY    = "no fstab entry"
+)
+
+type (
+	DiskStatus struct {
+		Host       string
+		Device     string
+		Filesystem string // the actual filesystem on device
+		UUID       string
+		MountPoint string // the declared mount point
+		MountedOn  string // the actual mount point
+		Size       uint64
+		InFstab    bool
+		Status     string
+	}
+
+	// deviceState is the current state of a disk on host
+	deviceState struct {
+		exist      bool
+		fstype     string
+		uuid       string
+		mountedOn  string
+		size       uint64
+		partitions []string
+	}
+
+	step2PrepareDisk struct {
+		host        string
+		disk        hosts.Disk
+		force       bool
+		execOptions module.ExecOptions
+	}
+
+	step2GetDiskStatus struct {
+		host        string
+		disk        hosts.Disk
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+)
+
+func FstabEntry(uuid string, disk hosts.Disk) string {
+	return fmt.Sprintf("UUID=%s %s %s %s 0 2", uuid, disk.MountPoint, disk.Filesystem, disk.Options)
+}
+
+/*
+ * updateFstab replaces the entries which mount the same device or
+ * on the same mount point with the new entry, returns the new content
+ * and whether it changed.
+ */
+func updateFstab(content, uuid string, disk hosts.Disk) (string, bool) {
+	entry := FstabEntry(uuid, disk)
+	lines := []string{}
+	found := false
+	content = strings.TrimRight(content, "\n")
+	for _, line := range strings.Split(content, "\n") {
+		if len(content) == 0 {
+			break
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			lines = append(lines, line)
+			continue
+		}
+
+		if fields[0] == "UUID="+uuid || fields[0] == disk.Device || fields[1] == disk.MountPoint {
+			if !found && strings.Join(fields, " ") == entry {
+				lines = append(lines, line)
+				found = true
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if !found {
+		lines = append(lines, entry)
+	}
+	newContent := strings.Join(lines, "\n") + "\n"
+	return newContent, newContent != content+"\n"
+}
+
+// removeFstabEntries removes the entries which mount the device (by UUID or path)
+// or on the mount point, returns the new content and whether it changed.
+func removeFstabEntries(content, uuid string, disk hosts.Disk) (string, bool) {
+	lines := []string{}
+	removed := false
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && !strings.HasPrefix(fields[0], "#") &&
+			((len(uuid) > 0 && fields[0] == "UUID="+uuid) ||
+				fields[0] == disk.Device || fields[1] == disk.MountPoint) {
+			removed = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if !removed {
+		return content, false
+	}
+	return strings.Join(lines, "\n") + "\n", true
+}
+
+func hasFstabEntry(content, uuid string, disk hosts.Disk) bool {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "UUID="+uuid && fields[1] == disk.MountPoint {
+			return true
+		}
+	}
+	return false
+}
+
+func getDeviceState(ctx *context.Context, device string, options module.ExecOptions) (deviceState, error) {
+	state := deviceState{}
+	_, err := ctx.Module().Shell().Test(device).AddOption("-b").Execute(options)
+	if err != nil {
+		return state, nil
+	}
+	state.exist = true
+
+	out, err := ctx.Module().Shell().LsBlk(device).
+		AddOption("--bytes --pairs --output NAME,TYPE,SIZE,FSTYPE,MOUNTPOINT").
+		Execute(options)
+	if err != nil {
+		return state, errno.ERR_LIST_BLOCK_DEVICES_FAILED.S(out)
+	}
+	for i, device := range parseLsBlk(out) {
+		if i == 0 {
+			state.size = device.Size
+			state.mountedOn = device.MountPoint
+		} else {
+			state.partitions = append(state.partitions, device.Name)
+			if len(device.MountPoint) > 0 && len(state.mountedOn) == 0 {
+				state.mountedOn = device.MountPoint
+			}
+		}
+	}
+
+	// blkid exits with 2 if device has no filesystem
+	out, err = ctx.Module().Shell().BlkId(device).AddOption("-o value -s TYPE").Execute(options)
+	if err == nil {
+		state.fstype = strings.TrimSpace(out)
+	}
+	out, err = ctx.Module().Shell().BlkId(device).AddOption("-o value -s UUID").Execute(options)
+	if err == nil {
+		state.uuid = strings.TrimSpace(out)
+	}
+	return state, nil
+}
+
+func (s *step2PrepareDisk) format(ctx *context.Context, state deviceState) error {
+	disk := s.disk
+	if len(state.fstype) > 0 || len(state.partitions) > 0 {
+		out, err := ctx.Module().Shell().Command("wipefs -a " + disk.Device).Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_BUILD_A_LINUX_FILE_SYSTEM_FAILED.S(out)
+		}
+	}
+
+	var cmd *module.Shell
+	if disk.Filesystem == hosts.DISK_FS_XFS {
+		cmd = ctx.Module().Shell().Command("mkfs.xfs -f " + disk.Device)
+	} else {
+		cmd = ctx.Module().Shell().Mkfs(disk.Device).AddOption("-F")
+	}
+	out, err := cmd.Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_BUILD_A_LINUX_FILE_SYSTEM_FAILED.S(out)
+	}
+	return nil
+}
+
+// umount the disk which will be formatted, and remove its stale entry in /etc/fstab,
+// otherwise the host may fail to boot for the entry mounts an UUID which no longer exists
+func (s *step2PrepareDisk) umount(ctx *context.Context, state deviceState) error {
+	if len(state.mountedOn) > 0 {
+		out, err := ctx.Module().Shell().Umount(state.mountedOn).Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_UMOUNT_DISK_FAILED.F("%s: %s: %s", s.host, s.disk.Device, out)
+		}
+	}
+
+	content, err := ctx.Module().Shell().Cat(FSTAB_FILE).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UPDATE_FSTAB_FAILED.S(content)
+	}
+	newContent, changed := removeFstabEntries(content, state.uuid, s.disk)
+	if !changed {
+		return nil
+	}
+	return s.writeFstab(ctx, newContent)
+}
+
+func (s *step2PrepareDisk) persist(ctx *context.Context, uuid string) error {
+	content, err := ctx.Module().Shell().Cat(FSTAB_FILE).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UPDATE_FSTAB_FAILED.S(content)
+	}
+	newContent, changed := updateFstab(content, uuid, s.disk)
+	if !changed {
+		return nil
+	}
+	return s.writeFstab(ctx, newContent)
+}
+
+func (s *step2PrepareDisk) writeFstab(ctx *context.Context, newContent string) error {
+	localPath := utils.RandFilename(step.TEMP_DIR)
+	defer os.Remove(localPath)
+	if err := utils.WriteFile(localPath, newContent, 0644); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	remotePath := utils.RandFilename(step.TEMP_DIR)
+	if err := ctx.Module().File().Upload(localPath, remotePath); err != nil {
+		return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+	}
+	defer ctx.Module().Shell().Remove(remotePath).AddOption("-f").Execute(s.execOptions)
+
+	// copy into the existing file to keep its owner and mode
+	out, err := ctx.Module().Shell().Copy(FSTAB_FILE, FSTAB_BACKUP_FILE).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UPDATE_FSTAB_FAILED.S(out)
+	}
+	out, err = ctx.Module().Shell().Copy(remotePath, FSTAB_FILE).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UPDATE_FSTAB_FAILED.S(out)
+	}
+	return nil
+}
+
+/*
+ * prepare disk idempotently:
+ *   (1) format the disk if it's empty, refuse to format the disk
+ *       which has filesystem or partitions unless force, the disk
+ *       mounted on the mount point is unmounted before formatting
+ *   (2) mount the disk on the mount point
+ *   (3) persist the mount in /etc/fstab by UUID
+ */
+func (s *step2PrepareDisk) Execute(ctx *context.Context) error {
+	disk := s.disk
+	state, err := getDeviceState(ctx, disk.Device, s.execOptions)
+	if err != nil {
+		return err
+	} else if !state.exist {
+		return errno.ERR_NOT_A_BLOCK_DEVICE.F("%s: %s", s.host, disk.Device)
+	} else if len(state.mountedOn) > 0 && state.mountedOn != disk.MountPoint {
+		return errno.ERR_DISK_MOUNTED_ELSEWHERE.
+			F("%s: %s mounted on %s", s.host, disk.Device, state.mountedOn)
+	}
+
+	// (1) format
+	reuse := state.fstype == disk.Filesystem && len(state.partitions) == 0
+	if !reuse {
+		if (len(state.fstype) > 0 || len(state.partitions) > 0) && !s.force {
+			return errno.ERR_DISK_HAS_DATA.F("%s: %s (filesystem=%s, partitions=%s)",
+				s.host, disk.Device, utils.Choose(len(state.fstype) > 0, state.fstype, "-"),
+				utils.Choose(len(state.partitions) > 0, strings.Join(state.partitions, ","), "-"))
+		} else if err := s.umount(ctx, state); err != nil {
+			return err
+		} else if err := s.format(ctx, state); err != nil {
+			return err
+		}
+		state.mountedOn = ""
+	}
+
+	// (2) mount
+	if len(state.mountedOn) == 0 {
+		out, err := ctx.Module().Shell().Mkdir(disk.MountPoint).AddOption("--parents").Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_CREATE_DIRECTORY_FAILED.S(out)
+		}
+		out, err = ctx.Module().Shell().Mount(disk.Device, disk.MountPoint).
+			AddOption("-t %s -o %s", disk.Filesystem, disk.Options).
+			Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_MOUNT_A_FILESYSTEM_FAILED.S(out)
+		}
+	}
+
+	// (3) persist
+	out, err := ctx.Module().Shell().BlkId(disk.Device).AddOption("-o value -s UUID").Execute(s.execOptions)
+	uuid := strings.TrimSpace(out)
+	if err != nil || len(uuid) == 0 {
+		return errno.ERR_GET_DEVICE_UUID_FAILED.F("%s: %s", s.host, disk.Device)
+	}
+	return s.persist(ctx, uuid)
+}
+
+func (s *step2GetDiskStatus) Execute(ctx *context.Context) error {
+	disk := s.disk
+	status := DiskStatus{
+		Host:       s.host,
+		Device:     disk.Device,
+		MountPoint: disk.MountPoint,
+	}
+	defer func() {
+		s.memStorage.TX(func(kv *utils.SafeMap) error {
+			m := map[string]DiskStatus{}
+			v := kv.Get(comm.KEY_ALL_DISK_STATUS)
+			if v != nil {
+				m = v.(map[string]DiskStatus)
+			}
+			m[s.host+":"+disk.Device] = status
+			kv.Set(comm.KEY_ALL_DISK_STATUS, m)
+			return nil
+		})
+	}()
+
+	state, err := getDeviceState(ctx, disk.Device, s.execOptions)
+	if err != nil {
+		return err
+	}
+	status.Filesystem, status.UUID = state.fstype, state.uuid
+	status.MountedOn, status.Size = state.mountedOn, state.size
+	if len(state.uuid) > 0 {
+		content, err := ctx.Module().Shell().Cat(FSTAB_FILE).Execute(s.execOptions)
+		if err != nil {
+			return errno.ERR_CONCATENATE_FILE_FAILED.S(content)
+		}
+		status.InFstab = hasFstabEntry(content, state.uuid, disk)
+	}
+
+	switch {
+	case !state.exist:
+		status.Status = DISK_STATUS_DEVICE_NOT_FOUND
+	case len(state.fstype) == 0 && len(state.partitions) == 0:
+		status.Status = DISK_STATUS_NOT_FORMATTED
+	case len(state.mountedOn) > 0 && state.mountedOn != disk.MountPoint:
+		status.Status = DISK_STATUS_MOUNTED_ELSEWHERE
+	case state.fstype != disk.Filesystem:
+		status.Status = DISK_STATUS_FS_MISMATCH
+	case len(state.mountedOn) == 0:
+		status.Status = DISK_STATUS_NOT_MOUNTED
+	case !status.InFstab:
+		status.Status = DISK_STATUS_NO_FSTAB_ENTRY
+	default:
+		status.Status = DISK_STATUS_READY
+	}
+	return nil
+}
+
+func NewPrepareDisksTask(dingocli *cli.DingoCli, hc *hosts.HostConfig) (*task.Task, error) {
+	disks := hc.GetDisks()
+	if len(disks) == 0 {
+		return nil, nil
+	}
+
+	force := dingocli.MemStorage().Get(comm.KEY_DISK_PREPARE_FORCE).(bool)
+	subname := fmt.Sprintf("host=%s disks=%d force=%v", hc.GetHost(), len(disks), force)
+	t := task.NewTask("Prepare Disks", subname, hc.GetSSHConfig())
+	for _, disk := range disks {
+		t.AddStep(&step2PrepareDisk{
+			host:        hc.GetHost(),
+			disk:        disk,
+			force:       force,
+			execOptions: dingocli.ExecOptions(),
+		})
+	}
+
+	return t, nil
+}
+
+func NewGetDisksStatusTask(dingocli *cli.DingoCli, hc *hosts.HostConfig) (*task.Task, error) {
+	disks := hc.GetDisks()
+	if len(disks) == 0 {
+		return nil, nil
+	}
+
+	subname := fmt.Sprintf("host=%s disks=%d", hc.GetHost(), len(disks))
+	t := task.NewTask("Get Disks Status", subname, hc.GetSSHConfig())
+	for _, disk := range disks {
+		t.AddStep(&step2GetDiskStatus{
+			host:        hc.GetHost(),
+			disk:        disk,
+			memStorage:  dingocli.MemStorage(),
+			execOptions: dingocli.ExecOptions(),
+		})
+	}
+
+	return t, nil
+}
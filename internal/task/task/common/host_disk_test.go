@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package common
+
+import (
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+)
+
+/*
+ * TestUpdateFstab, run: go test ./internal/task/task/common -run ^TestUpdateFstab$
+ */
+func TestUpdateFstab(t *testing.T) {
+	disk := hosts.Disk{
+		Device:     "/dev/sdb",
+		Filesystem: "ext4",
+		MountPoint: "/data1",
+		Options:    "defaults",
+	}
+	entry := "UUID=1234 /data1 ext4 defaults 0 2"
+	root := "UUID=abcd / xfs defaults 0 0"
+
+	tests := []struct {
+		name    string
+		content string
+		expect  string
+		changed bool
+	}{
+		{"empty", "", entry + "\n", true},
+		{"append", root + "\n", root + "\n" + entry + "\n", true},
+		{"append without newline", root, root + "\n" + entry + "\n", true},
+		{"unchanged", root + "\n" + entry + "\n", root + "\n" + entry + "\n", false},
+		{"unchanged with spaces", "UUID=1234  /data1\text4 defaults 0 2\n", "UUID=1234  /data1\text4 defaults 0 2\n", false},
+		{"keep comments", "# /etc/fstab\n" + root + "\n", "# /etc/fstab\n" + root + "\n" + entry + "\n", true},
+		{"replace device", "/dev/sdb /data1 xfs defaults 0 0\n", entry + "\n", true},
+		{"replace mount point", "UUID=5678 /data1 ext4 defaults 0 2\n", entry + "\n", true},
+		{"replace options", "UUID=1234 /data1 ext4 noatime 0 2\n", entry + "\n", true},
+		{"remove duplicates", entry + "\n" + root + "\n" + entry + "\n", entry + "\n" + root + "\n", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, changed := updateFstab(tt.content, "1234", disk)
+			if content != tt.expect {
+				t.Errorf("expect content %q, got %q", tt.expect, content)
+			}
+			if changed != tt.changed {
+				t.Errorf("expect changed=%v, got %v", tt.changed, changed)
+			}
+		})
+	}
+}
+
+/*
+ * TestRemoveFstabEntries, run: go test ./internal/task/task/common -run ^TestRemoveFstabEntries$
+ */
+func TestRemoveFstabEntries(t *testing.T) {
+	disk := hosts.Disk{
+		Device:     "/dev/sdb",
+		Filesystem: "ext4",
+		MountPoint: "/data1",
+		Options:    "defaults",
+	}
+	root := "UUID=abcd / xfs defaults 0 0"
+
+	tests := []struct {
+		name    string
+		content string
+		uuid    string
+		expect  string
+		changed bool
+	}{
+		{"empty", "", "1234", "", false},
+		{"no entry", root + "\n", "1234", root + "\n", false},
+		{"by uuid", root + "\nUUID=1234 /data2 xfs defaults 0 2\n", "1234", root + "\n", true},
+		{"by device", "/dev/sdb /data2 xfs defaults 0 0\n" + root + "\n", "1234", root + "\n", true},
+		{"by mount point", root + "\nUUID=5678 /data1 xfs defaults 0 2\n", "", root + "\n", true},
+		{"keep comments", "# UUID=1234 /data1\n" + root, "1234", "# UUID=1234 /data1\n" + root, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, changed := removeFstabEntries(tt.content, tt.uuid, disk)
+			if content != tt.expect {
+				t.Errorf("expect content %q, got %q", tt.expect, content)
+			}
+			if changed != tt.changed {
+				t.Errorf("expect changed=%v, got %v", tt.changed, changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/hosts"
+	"github.com/dingodb/dingocli/internal/storage"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	FACT_HOSTNAME = "hostname"
+	FACT_OS       = "os"
+	FACT_KERNEL   = "kernel"
+	FACT_ARCH     = "arch"
+	FACT_CPU      = "cpu"
+	FACT_MEMORY   = "memory"
+	FACT_NUMA     = "numa"
+	FACT_BLOCK    = "block"
+	FACT_FS       = "filesystem"
+	FACT_NIC      = "nic"
+	FACT_ADDRESS  = "address"
+	FACT_DOCKER   = "docker"
+	FACT_FUSE     = "fuse"
+
+	SYS_NODE_DIR = "/sys/devices/system/node"
+	SYS_NET_DIR  = "/sys/class/net"
+	DEVICE_FUSE  = "/dev/fuse"
+
+	NIC_SPEED_UNKNOWN = -1
+)
+
+var (
+	REGEX_KV_PAIR        = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	REGEX_NODE_MEMTOTAL  = regexp.MustCompile(`^Node\s+(\d+)\s+MemTotal:\s+(\d+)\s+kB`)
+	REGEX_NODE_CPULIST   = regexp.MustCompile(`/node(\d+)/cpulist:(.*)$`)
+	REGEX_SYS_NET_ITEM   = regexp.MustCompile(`^` + SYS_NET_DIR + `/([^/]+)/(\w+):(.*)$`)
+	REGEX_IP_ADDRESS     = regexp.MustCompile(`^\d+:\s+(\S+)\s+inet6?\s+(\S+)`)
+	REGEX_DOCKER_VERSION = regexp.MustCompile(`Server Version:\s*(\S+)`)
+
+	// virtual interfaces created by container engine or bridge are not interested
+	VIRTUAL_NIC_PREFIXES = []string{"lo", "veth", "docker", "br-", "virbr", "cni", "flannel", "cali"}
+)
+
+type (
+	CPUFacts struct {
+		Model string `json:"model"`
+		Cores int    `json:"cores"`
+	}
+
+	NumaNode struct {
+		Id     int    `json:"id"`
+		CPUs   string `json:"cpus"`
+		Memory uint64 `json:"memory"`
+	}
+
+	BlockDevice struct {
+		Name       string `json:"name"`
+		Type       string `json:"type"`
+		Size       uint64 `json:"size"`
+		Rotational bool   `json:"rotational"`
+		FsType     string `json:"fstype,omitempty"`
+		MountPoint string `json:"mountpoint,omitempty"`
+		Model      string `json:"model,omitempty"`
+	}
+
+	Filesystem struct {
+		Device     string `json:"device"`
+		Type       string `json:"type"`
+		Size       uint64 `json:"size"`
+		Used       uint64 `json:"used"`
+		Available  uint64 `json:"available"`
+		MountPoint string `json:"mountpoint"`
+	}
+
+	NIC struct {
+		Name      string   `json:"name"`
+		State     string   `json:"state"`
+		Speed     int      `json:"speed"` // Mb/s, -1 means unknown
+		MTU       int      `json:"mtu"`
+		MAC       string   `json:"mac"`
+		Addresses []string `json:"addresses,omitempty"`
+	}
+
+	HostFacts struct {
+		Host         string            `json:"host"`
+		Hostname     string            `json:"hostname"`
+		OS           string            `json:"os"`
+		Kernel       string            `json:"kernel"`
+		Arch         string            `json:"arch"`
+		CPU          CPUFacts          `json:"cpu"`
+		Memory       uint64            `json:"memory"`
+		NUMA         []NumaNode        `json:"numa"`
+		BlockDevices []BlockDevice     `json:"block_devices"`
+		Filesystems  []Filesystem      `json:"filesystems"`
+		NICs         []NIC             `json:"nics"`
+		Docker       string            `json:"docker"`
+		Fuse         bool              `json:"fuse"`
+		CollectedAt  time.Time         `json:"collected_at"`
+		Errors       map[string]string `json:"errors,omitempty"`
+	}
+
+	factExecutor interface {
+		Execute(options module.ExecOptions) (string, error)
+	}
+
+	step2CollectHostFacts struct {
+		host        string
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+)
+
+func parseOSRelease(out string) string {
+	name := ""
+	for _, line := range strings.Split(out, "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.Trim(kv[1], "\"'")
+		if kv[0] == "PRETTY_NAME" {
+			return value
+		} else if kv[0] == "NAME" {
+			name = value
+		}
+	}
+	return name
+}
+
+func parseCPUInfo(out string) CPUFacts {
+	cpu := CPUFacts{}
+	for _, line := range strings.Split(out, "\n") {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(kv[0]) {
+		case "processor":
+			cpu.Cores++
+		case "model name":
+			if len(cpu.Model) == 0 {
+				cpu.Model = strings.TrimSpace(kv[1])
+			}
+		}
+	}
+	return cpu
+}
+
+func parseMemInfo(out string) uint64 {
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, _ := strconv.ParseUint(fields[1], 10, 64)
+			return kb * 1024
+		}
+	}
+	return 0
+}
+
+// cpulist: /sys/devices/system/node/node0/cpulist:0-15
+// meminfo: Node 0 MemTotal:       32768 kB
+func parseNumaNodes(cpulist, meminfo string) []NumaNode {
+	nodes := map[int]*NumaNode{}
+	getNode := func(id int) *NumaNode {
+		if _, ok := nodes[id]; !ok {
+			nodes[id] = &NumaNode{Id: id}
+		}
+		return nodes[id]
+	}
+	for _, line := range strings.Split(cpulist, "\n") {
+		if mu := REGEX_NODE_CPULIST.FindStringSubmatch(strings.TrimSpace(line)); len(mu) > 0 {
+			id, _ := strconv.Atoi(mu[1])
+			getNode(id).CPUs = strings.TrimSpace(mu[2])
+		}
+	}
+	for _, line := range strings.Split(meminfo, "\n") {
+		if mu := REGEX_NODE_MEMTOTAL.FindStringSubmatch(strings.TrimSpace(line)); len(mu) > 0 {
+			id, _ := strconv.Atoi(mu[1])
+			kb, _ := strconv.ParseUint(mu[2], 10, 64)
+			getNode(id).Memory = kb * 1024
+		}
+	}
+
+	numa := []NumaNode{}
+	for _, node := range nodes {
+		numa = append(numa, *node)
+	}
+	sort.Slice(numa, func(i, j int) bool { return numa[i].Id < numa[j].Id })
+	return numa
+}
+
+// NAME="sda" TYPE="disk" SIZE="480103981056" ROTA="0" FSTYPE="" MOUNTPOINT="" MODEL="INTEL SSDSC2KB48"
+func parseLsBlk(out string) []BlockDevice {
+	devices := []BlockDevice{}
+	for _, line := range strings.Split(out, "\n") {
+		pairs := REGEX_KV_PAIR.FindAllStringSubmatch(line, -1)
+		if len(pairs) == 0 {
+			continue
+		}
+		device := BlockDevice{}
+		for _, pair := range pairs {
+			value := strings.TrimSpace(pair[2])
+			switch pair[1] {
+			case "NAME":
+				device.Name = value
+			case "TYPE":
+				device.Type = value
+			case "SIZE":
+				device.Size, _ = strconv.ParseUint(value, 10, 64)
+			case "ROTA":
+				device.Rotational = value == "1"
+			case "FSTYPE":
+				device.FsType = value
+			case "MOUNTPOINT":
+				device.MountPoint = value
+			case "MODEL":
+				device.Model = value
+			}
+		}
+		devices = append(devices, device)
+	}
+	return devices
+}
+
+// Filesystem Type 1-blocks Used Available Capacity Mounted on
+func parseDiskFree(out string) []Filesystem {
+	filesystems := []Filesystem{}
+	for i, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if i == 0 || len(fields) < 7 {
+			continue
+		}
+		fs := Filesystem{
+			Device:     fields[0],
+			Type:       fields[1],
+			MountPoint: strings.Join(fields[6:], " "),
+		}
+		fs.Size, _ = strconv.ParseUint(fields[2], 10, 64)
+		fs.Used, _ = strconv.ParseUint(fields[3], 10, 64)
+		fs.Available, _ = strconv.ParseUint(fields[4], 10, 64)
+		filesystems = append(filesystems, fs)
+	}
+	return filesystems
+}
+
+func isVirtualNIC(name string) bool {
+	for _, prefix := range VIRTUAL_NIC_PREFIXES {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sysnet: /sys/class/net/eth0/speed:10000
+// address: 2: eth0    inet 10.0.0.1/24 brd 10.0.0.255 scope global eth0 ...
+func parseNICs(sysnet, address string) []NIC {
+	nics := map[string]*NIC{}
+	for _, line := range strings.Split(sysnet, "\n") {
+		mu := REGEX_SYS_NET_ITEM.FindStringSubmatch(strings.TrimSpace(line))
+		if len(mu) == 0 || isVirtualNIC(mu[1]) {
+			continue
+		}
+		nic, ok := nics[mu[1]]
+		if !ok {
+			nic = &NIC{Name: mu[1], Speed: NIC_SPEED_UNKNOWN}
+			nics[mu[1]] = nic
+		}
+		value := strings.TrimSpace(mu[3])
+		switch mu[2] {
+		case "operstate":
+			nic.State = value
+		case "speed":
+			if speed, err := strconv.Atoi(value); err == nil && speed > 0 {
+				nic.Speed = speed
+			}
+		case "mtu":
+			nic.MTU, _ = strconv.Atoi(value)
+		case "address":
+			nic.MAC = value
+		}
+	}
+	for _, line := range strings.Split(address, "\n") {
+		mu := REGEX_IP_ADDRESS.FindStringSubmatch(strings.TrimSpace(line))
+		if len(mu) == 0 {
+			continue
+		} else if nic, ok := nics[mu[1]]; ok {
+			nic.Addresses = append(nic.Addresses, mu[2])
+		}
+	}
+
+	result := []NIC{}
+	for _, nic := range nics {
+		result = append(result, *nic)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func parseDockerVersion(out string) string {
+	if mu := REGEX_DOCKER_VERSION.FindStringSubmatch(out); len(mu) > 0 {
+		return mu[1]
+	}
+	return ""
+}
+
+/*
+ * collect facts one by one, the failure of one fact should not
+ * stop collecting others, so we record the error into facts.
+ */
+func (s *step2CollectHostFacts) Execute(ctx *context.Context) error {
+	facts := HostFacts{
+		Host:         s.host,
+		NUMA:         []NumaNode{},
+		BlockDevices: []BlockDevice{},
+		Filesystems:  []Filesystem{},
+		NICs:         []NIC{},
+		CollectedAt:  time.Now(),
+		Errors:       map[string]string{},
+	}
+	execute := func(fact string, cmd factExecutor, tolerant bool) string {
+		out, err := cmd.Execute(s.execOptions)
+		if err != nil && !(tolerant && len(out) > 0) {
+			facts.Errors[fact] = strings.TrimSpace(utils.Choose(len(out) > 0, out, err.Error()))
+			log.Warn("Collect host fact failed",
+				log.Field("host", s.host),
+				log.Field("fact", fact),
+				log.Field("error", err))
+			return ""
+		}
+		return out
+	}
+	shell := func() *module.Shell { return ctx.Module().Shell() }
+
+	facts.Hostname = strings.TrimSpace(execute(FACT_HOSTNAME, shell().Command("hostname"), false))
+	facts.OS = parseOSRelease(execute(FACT_OS, shell().Cat("/etc/os-release"), false))
+	facts.Kernel = strings.TrimSpace(execute(FACT_KERNEL, shell().UnixName().AddOption("-r"), false))
+	facts.Arch = strings.TrimSpace(execute(FACT_ARCH, shell().UnixName().AddOption("-m"), false))
+	facts.CPU = parseCPUInfo(execute(FACT_CPU, shell().Cat("/proc/cpuinfo"), false))
+	facts.Memory = parseMemInfo(execute(FACT_MEMORY, shell().Cat("/proc/meminfo"), false))
+	facts.NUMA = parseNumaNodes(
+		execute(FACT_NUMA, shell().Grep(".", SYS_NODE_DIR+"/node*/cpulist").AddOption("-H"), true),
+		execute(FACT_NUMA, shell().Grep("MemTotal", SYS_NODE_DIR+"/node*/meminfo"), true))
+	facts.BlockDevices = parseLsBlk(execute(FACT_BLOCK, shell().LsBlk().
+		AddOption("--bytes --pairs --output NAME,TYPE,SIZE,ROTA,FSTYPE,MOUNTPOINT,MODEL"), false))
+	facts.Filesystems = parseDiskFree(execute(FACT_FS, shell().DiskFree().
+		AddOption("-P -T -B1 -x tmpfs -x devtmpfs -x overlay -x squashfs"), true))
+	// reading speed of the NIC which is down returns EINVAL
+	facts.NICs = parseNICs(
+		execute(FACT_NIC, shell().Grep(".",
+			SYS_NET_DIR+"/*/operstate", SYS_NET_DIR+"/*/speed",
+			SYS_NET_DIR+"/*/mtu", SYS_NET_DIR+"/*/address").AddOption("-H -s"), true),
+		execute(FACT_ADDRESS, shell().Command("ip -o addr show"), false))
+	facts.Docker = parseDockerVersion(execute(FACT_DOCKER, ctx.Module().DockerCli().DockerInfo(), true))
+	_, err := shell().Test(DEVICE_FUSE).AddOption("-c").Execute(s.execOptions)
+	facts.Fuse = err == nil
+
+	s.memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]HostFacts{}
+		v := kv.Get(comm.KEY_ALL_HOST_FACTS)
+		if v != nil {
+			m = v.(map[string]HostFacts)
+		}
+		m[s.host] = facts
+		kv.Set(comm.KEY_ALL_HOST_FACTS, m)
+		return nil
+	})
+	return nil
+}
+
+func NewCollectHostFactsTask(dingocli *cli.DingoCli, hc *hosts.HostConfig) (*task.Task, error) {
+	subname := fmt.Sprintf("host=%s", hc.GetHost())
+	t := task.NewTask("Collect Host Facts", subname, hc.GetSSHConfig())
+	t.AddStep(&step2CollectHostFacts{
+		host:        hc.GetHost(),
+		memStorage:  dingocli.MemStorage(),
+		execOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// SaveHostFacts caches the facts into database, so checkers can reuse them
+func SaveHostFacts(s *storage.Storage, facts HostFacts) error {
+	data, err := json.Marshal(facts)
+	if err != nil {
+		return err
+	}
+	return s.SetHostFacts(facts.Host, string(data))
+}
+
+// LoadHostFacts returns the cached facts of host, nil if it's not collected yet
+func LoadHostFacts(s *storage.Storage, host string) (*HostFacts, error) {
+	items, err := s.GetHostFacts(host)
+	if err != nil {
+		return nil, err
+	} else if len(items) == 0 {
+		return nil, nil
+	}
+
+	facts := &HostFacts{}
+	if err := json.Unmarshal([]byte(items[0].Data), facts); err != nil {
+		return nil, err
+	}
+	return facts, nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+/*
+ * TestParseLsBlk, run: go test ./internal/task/task/common -run ^TestParseLsBlk$
+ */
+func TestParseLsBlk(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		expect []BlockDevice
+	}{
+		{"empty", "", []BlockDevice{}},
+		{
+			"disks",
+			`NAME="sda" TYPE="disk" SIZE="480103981056" ROTA="0" FSTYPE="" MOUNTPOINT="" MODEL="INTEL SSDSC2KB48"
+NAME="sda1" TYPE="part" SIZE="1073741824" ROTA="0" FSTYPE="xfs" MOUNTPOINT="/boot" MODEL=""
+NAME="nvme0n1" TYPE="disk" SIZE="3840755982336" ROTA="0" FSTYPE="ext4" MOUNTPOINT="/data1" MODEL="SAMSUNG MZQL23T8HCLS-00A07 "
+NAME="sdb" TYPE="disk" SIZE="4000787030016" ROTA="1" FSTYPE="" MOUNTPOINT="" MODEL="ST4000NM0035-1V4"
+`,
+			[]BlockDevice{
+				{Name: "sda", Type: "disk", Size: 480103981056, Model: "INTEL SSDSC2KB48"},
+				{Name: "sda1", Type: "part", Size: 1073741824, FsType: "xfs", MountPoint: "/boot"},
+				{Name: "nvme0n1", Type: "disk", Size: 3840755982336, FsType: "ext4", MountPoint: "/data1", Model: "SAMSUNG MZQL23T8HCLS-00A07"},
+				{Name: "sdb", Type: "disk", Size: 4000787030016, Rotational: true, Model: "ST4000NM0035-1V4"},
+			},
+		},
+		{
+			"invalid size",
+			`NAME="loop0" TYPE="loop" SIZE="" ROTA="0"`,
+			[]BlockDevice{{Name: "loop0", Type: "loop"}},
+		},
+		{
+			"mount point with space",
+			`NAME="sdc" TYPE="disk" SIZE="1024" ROTA="0" FSTYPE="ext4" MOUNTPOINT="/mnt/my data" MODEL=""`,
+			[]BlockDevice{{Name: "sdc", Type: "disk", Size: 1024, FsType: "ext4", MountPoint: "/mnt/my data"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices := parseLsBlk(tt.out)
+			if !reflect.DeepEqual(devices, tt.expect) {
+				t.Errorf("expect %+v, got %+v", tt.expect, devices)
+			}
+		})
+	}
+}
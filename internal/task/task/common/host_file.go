@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"os"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+/*
+ * installHostFile writes content into the file of host, it uploads the content
+ * into temporary file and copies it into the dest, so the existing file keeps
+ * its owner and mode, and the new file is owned by the sudo user.
+ */
+func installHostFile(ctx *context.Context, content, dest string, options module.ExecOptions) error {
+	localPath := utils.RandFilename(step.TEMP_DIR)
+	defer os.Remove(localPath)
+	if err := utils.WriteFile(localPath, content, 0644); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+
+	remotePath := utils.RandFilename(step.TEMP_DIR)
+	if err := ctx.Module().File().Upload(localPath, remotePath); err != nil {
+		return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+	}
+	defer ctx.Module().Shell().Remove(remotePath).AddOption("-f").Execute(options)
+
+	out, err := ctx.Module().Shell().Copy(remotePath, dest).Execute(options)
+	if err != nil {
+		return errno.ERR_COPY_FILES_AND_DIRECTORIES_FAILED.S(out)
+	}
+	return nil
+}
@@ -0,0 +1,621 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/os"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/storage"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	TUNE_MODE_APPLY  = "apply"
+	TUNE_MODE_CHECK  = "check"
+	TUNE_MODE_REVERT = "revert"
+
+	TUNE_KIND_SYSCTL     = "sysctl"
+	TUNE_KIND_ULIMIT     = "ulimit"
+	TUNE_KIND_SYSFS      = "sysfs"
+	TUNE_KIND_FUSE       = "fuse"
+	TUNE_KIND_IRQBALANCE = "irqbalance"
+
+	TUNE_ACTION_OK          = "ok"
+	TUNE_ACTION_CHANGED     = "changed"
+	TUNE_ACTION_DRIFT       = "drift"
+	TUNE_ACTION_REVERTED    = "reverted"
+	TUNE_ACTION_UNSUPPORTED = "unsupported"
+	TUNE_ACTION_SKIPPED     = "skipped"
+	TUNE_ACTION_FAILED      = "failed"
+
+	TUNE_CONF_NAME        = "99-dingofs.conf"
+	TUNE_UNIT_NAME        = "dingofs-tune.service"
+	TUNE_IRQBALANCE       = "irqbalance"
+	TUNE_SERVICE_ACTIVE   = "active"
+	TUNE_ULIMIT_NOFILE    = "nofile"
+	TUNE_SERVICE_ENABLED  = "service"
+	TUNE_FUSE_ALLOW_OTHER = "user_allow_other"
+	TUNE_YES              = "yes"
+	TUNE_NO               = "no"
+
+	// the sysfs path may be a glob which matches many files
+	TUNE_THP_PATH            = "/sys/kernel/mm/transparent_hugepage/enabled"
+	TUNE_CPU_GOVERNOR_PATH   = "/sys/devices/system/cpu/cpu*/cpufreq/scaling_governor"
+	TUNE_CPU_EPB_PATH        = "/sys/devices/system/cpu/cpu*/power/energy_perf_bias"
+	TUNE_CPU_MIN_PERF_PATH   = "/sys/devices/system/cpu/intel_pstate/min_perf_pct"
+	TUNE_SD_SCHEDULER_PATH   = "/sys/block/sd*/queue/scheduler"
+	TUNE_SD_READAHEAD_PATH   = "/sys/block/sd*/queue/read_ahead_kb"
+	TUNE_NVME_SCHEDULER_PATH = "/sys/block/nvme*/queue/scheduler"
+	TUNE_NVME_READAHEAD_PATH = "/sys/block/nvme*/queue/read_ahead_kb"
+
+	TUNE_GENERATED_HEADER = "# generated by dingo hosts tune\n"
+
+	// sysfs items are lost after reboot, so they are written by the unit on boot
+	TUNE_UNIT_FORMAT = TUNE_GENERATED_HEADER + `[Unit]
+Description=Tune sysfs for dingo services
+After=local-fs.target
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+%s
+[Install]
+WantedBy=multi-user.target
+`
+)
+
+var (
+	// e.g. "always madvise [never]", "[mq-deadline] kyber bfq none"
+	REGEX_SYSFS_SELECTED = regexp.MustCompile(`\[([^\]]+)\]`)
+
+	// the item can't be set back by dingo, e.g. the limit configured by other files
+	errRevertUnsupported = fmt.Errorf("revert is not supported")
+
+	// base profile for all roles
+	TUNE_PROFILE_BASE = []TuneItem{
+		{TUNE_KIND_SYSCTL, "kernel.sched_min_granularity_ns", "10000000"},
+		{TUNE_KIND_SYSCTL, "kernel.sched_wakeup_granularity_ns", "15000000"},
+		{TUNE_KIND_SYSCTL, "kernel.numa_balancing", "1"},
+		{TUNE_KIND_SYSCTL, "kernel.io_uring_disabled", "0"},
+		{TUNE_KIND_SYSCTL, "vm.dirty_ratio", "40"},
+		{TUNE_KIND_SYSCTL, "vm.dirty_background_ratio", "10"},
+		{TUNE_KIND_SYSCTL, "vm.swappiness", "10"},
+		{TUNE_KIND_SYSCTL, "vm.nr_hugepages", "1024"},
+		{TUNE_KIND_SYSCTL, "vm.max_map_count", "655360"},
+		{TUNE_KIND_SYSCTL, "net.ipv4.tcp_window_scaling", "1"},
+		{TUNE_KIND_SYSCTL, "net.ipv4.tcp_timestamps", "1"},
+		{TUNE_KIND_ULIMIT, TUNE_ULIMIT_NOFILE, "65536"},
+		{TUNE_KIND_SYSFS, TUNE_THP_PATH, "always"},
+		{TUNE_KIND_SYSFS, TUNE_CPU_GOVERNOR_PATH, "performance"},
+		{TUNE_KIND_SYSFS, TUNE_CPU_EPB_PATH, "0"}, // 0 means performance
+		{TUNE_KIND_SYSFS, TUNE_CPU_MIN_PERF_PATH, "100"},
+		{TUNE_KIND_SYSFS, TUNE_SD_SCHEDULER_PATH, "mq-deadline"},
+		{TUNE_KIND_SYSFS, TUNE_SD_READAHEAD_PATH, "0"},
+		{TUNE_KIND_SYSFS, TUNE_NVME_SCHEDULER_PATH, "none"},
+		{TUNE_KIND_SYSFS, TUNE_NVME_READAHEAD_PATH, "0"},
+		{TUNE_KIND_FUSE, TUNE_FUSE_ALLOW_OTHER, TUNE_YES},
+		{TUNE_KIND_IRQBALANCE, TUNE_SERVICE_ENABLED, TUNE_SERVICE_ACTIVE},
+	}
+
+	// RocksDB based roles prefer THP disabled and less swapping
+	tuneProfileRocksDB = []TuneItem{
+		{TUNE_KIND_SYSCTL, "vm.swappiness", "1"},
+		{TUNE_KIND_ULIMIT, TUNE_ULIMIT_NOFILE, "1048576"},
+		{TUNE_KIND_SYSFS, TUNE_THP_PATH, "never"},
+	}
+
+	// role specific items override the items of base profile
+	TUNE_PROFILE_ROLES = map[string][]TuneItem{
+		topology.ROLE_FS_MDS: {
+			{TUNE_KIND_ULIMIT, TUNE_ULIMIT_NOFILE, "1048576"},
+		},
+		topology.ROLE_COORDINATOR:      tuneProfileRocksDB,
+		topology.ROLE_STORE:            tuneProfileRocksDB,
+		topology.ROLE_DINGODB_DOCUMENT: tuneProfileRocksDB,
+		topology.ROLE_DINGODB_INDEX:    tuneProfileRocksDB,
+		topology.ROLE_DINGODB_DISKANN:  tuneProfileRocksDB,
+	}
+)
+
+type (
+	TuneItem struct {
+		Kind  string
+		Key   string
+		Value string
+	}
+
+	TuneResult struct {
+		Host     string
+		Kind     string
+		Key      string
+		Before   string
+		Expected string
+		Action   string
+		Error    string
+	}
+
+	// TuneSnapshot saves the original values before the first tuning
+	TuneSnapshot struct {
+		Host      string            `json:"host"`
+		Values    map[string]string `json:"values"` // kind:key -> value, key of sysfs is the file
+		CreatedAt time.Time         `json:"created_at"`
+	}
+
+	step2TuneHost struct {
+		host        string
+		roles       []string
+		mode        string
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+		release     os.Release
+		results     []TuneResult
+	}
+)
+
+func (item TuneItem) Id() string {
+	return item.Kind + ":" + item.Key
+}
+
+/*
+ * GetTuneProfile merges the base profile and the profiles of roles,
+ * if roles conflict: the larger nofile wins and THP 'never' wins,
+ * otherwise the role which sorted first wins.
+ */
+func GetTuneProfile(roles []string) []TuneItem {
+	roles = append([]string{}, roles...)
+	sort.Strings(roles)
+
+	items := append([]TuneItem{}, TUNE_PROFILE_BASE...)
+	index := map[string]int{}
+	for i, item := range items {
+		index[item.Id()] = i
+	}
+	overrided := map[string]bool{}
+	for _, role := range roles {
+		for _, item := range TUNE_PROFILE_ROLES[role] {
+			i, ok := index[item.Id()]
+			if !ok {
+				index[item.Id()] = len(items)
+				overrided[item.Id()] = true
+				items = append(items, item)
+				continue
+			}
+
+			old := items[i]
+			switch {
+			case !overrided[item.Id()]:
+				items[i] = item
+			case item.Kind == TUNE_KIND_ULIMIT:
+				n1, _ := strconv.Atoi(old.Value)
+				n2, _ := strconv.Atoi(item.Value)
+				if n2 > n1 {
+					items[i] = item
+				}
+			case item.Kind == TUNE_KIND_SYSFS && item.Key == TUNE_THP_PATH && item.Value == "never":
+				items[i] = item
+			}
+			overrided[item.Id()] = true
+		}
+	}
+	return items
+}
+
+func sysctlConfPath() string { return path.Join(os.GetSysctlDir(), TUNE_CONF_NAME) }
+func limitsConfPath() string { return path.Join(os.GetLimitsDir(), TUNE_CONF_NAME) }
+func tuneUnitPath() string   { return path.Join(os.GetSystemdDir(), TUNE_UNIT_NAME) }
+
+// file:value lines printed by 'grep -H . <glob>', the selected one is picked if listed
+func parseSysfsValues(out string) map[string]string {
+	values := map[string]string{}
+	for _, line := range strings.Split(out, "\n") {
+		file, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		} else if mu := REGEX_SYSFS_SELECTED.FindStringSubmatch(value); len(mu) > 0 {
+			value = mu[1]
+		}
+		values[file] = strings.TrimSpace(value)
+	}
+	return values
+}
+
+// summarizeValues returns the distinct values joined by ',', e.g. "mq-deadline,none"
+func summarizeValues(values map[string]string) string {
+	distinct := []string{}
+	for _, value := range values {
+		if !utils.Contains(distinct, value) {
+			distinct = append(distinct, value)
+		}
+	}
+	sort.Strings(distinct)
+	return strings.Join(distinct, ",")
+}
+
+func (s *step2TuneHost) execute(ctx *context.Context, command string) (string, error) {
+	out, err := ctx.Module().Shell().Command(command).Execute(s.execOptions)
+	return strings.TrimSpace(out), err
+}
+
+/*
+ * current returns the current value of item and the values to snapshot,
+ * the value of each file which sysfs item matched is saved for revert.
+ */
+func (s *step2TuneHost) current(ctx *context.Context, item TuneItem) (string, map[string]string, error) {
+	value := ""
+	var err error
+	switch item.Kind {
+	case TUNE_KIND_SYSCTL:
+		value, err = s.execute(ctx, "sysctl -n "+item.Key)
+		value = strings.Join(strings.Fields(value), " ")
+	case TUNE_KIND_ULIMIT:
+		// the limits of SSH session may differ from the configured ones
+		var out string
+		out, err = s.execute(ctx, os.ReadLimitsCommand())
+		value = os.GetLimit(os.ParseLimits(out), item.Key)
+	case TUNE_KIND_SYSFS:
+		out, err := s.execute(ctx, fmt.Sprintf("bash -c 'grep -H . %s'", item.Key))
+		values := parseSysfsValues(out)
+		if err != nil || len(values) == 0 {
+			return "", nil, fmt.Errorf("read %s failed: %s", item.Key, out)
+		}
+		origins := map[string]string{}
+		for file, value := range values {
+			origins[TuneItem{Kind: TUNE_KIND_SYSFS, Key: file}.Id()] = value
+		}
+		return summarizeValues(values), origins, nil
+	case TUNE_KIND_FUSE:
+		value, err = s.execute(ctx, fmt.Sprintf("bash -c 'grep -qs \"^%s\" %s && echo %s || echo %s'",
+			item.Key, os.GetFuseConfPath(), TUNE_YES, TUNE_NO))
+	case TUNE_KIND_IRQBALANCE:
+		// is-active exits with non-zero if the service is not active
+		value, err = s.execute(ctx, "systemctl is-active "+TUNE_IRQBALANCE)
+		if len(value) > 0 {
+			err = nil
+		}
+	default:
+		err = fmt.Errorf("unknown tune kind: %s", item.Kind)
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+	return value, map[string]string{item.Id(): value}, nil
+}
+
+func (s *step2TuneHost) record(item TuneItem, before, expected, action string, err error) {
+	result := TuneResult{
+		Host:     s.host,
+		Kind:     item.Kind,
+		Key:      item.Key,
+		Before:   before,
+		Expected: expected,
+		Action:   action,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		log.Warn("Tune host failed",
+			log.Field("host", s.host),
+			log.Field("item", item.Id()),
+			log.Field("error", err))
+	}
+	s.results = append(s.results, result)
+}
+
+func (s *step2TuneHost) apply(ctx *context.Context, items []TuneItem, befores map[string]string) {
+	sysctl, limits, unit := "", "", ""
+	pending := []TuneItem{}
+	for _, item := range items {
+		switch item.Kind {
+		case TUNE_KIND_SYSCTL:
+			sysctl += fmt.Sprintf("%s = %s\n", item.Key, item.Value)
+		case TUNE_KIND_ULIMIT:
+			for _, user := range []string{"*", "root"} {
+				limits += fmt.Sprintf("%s soft %s %s\n%s hard %s %s\n",
+					user, item.Key, item.Value, user, item.Key, item.Value)
+			}
+		case TUNE_KIND_SYSFS: // '$$' is the escaped '$' in unit file
+			unit += fmt.Sprintf("ExecStart=-/bin/sh -c 'for f in %s; do echo %s > \"$$f\"; done'\n",
+				item.Key, item.Value)
+		}
+		if befores[item.Id()] == item.Value {
+			s.record(item, befores[item.Id()], item.Value, TUNE_ACTION_OK, nil)
+		} else {
+			pending = append(pending, item)
+		}
+	}
+
+	// the configure files are always rewritten to persist all items
+	var sysctlErr, limitsErr, unitErr error
+	if len(sysctl) > 0 {
+		sysctlErr = installHostFile(ctx, TUNE_GENERATED_HEADER+sysctl, sysctlConfPath(), s.execOptions)
+		if sysctlErr == nil {
+			_, sysctlErr = s.execute(ctx, "sysctl -p "+sysctlConfPath())
+		}
+	}
+	if len(limits) > 0 {
+		limitsErr = installHostFile(ctx, TUNE_GENERATED_HEADER+limits, limitsConfPath(), s.execOptions)
+	}
+	if len(unit) > 0 {
+		unitErr = installHostFile(ctx, fmt.Sprintf(TUNE_UNIT_FORMAT, unit), tuneUnitPath(), s.execOptions)
+		for _, command := range []string{
+			"systemctl daemon-reload",
+			"systemctl enable " + TUNE_UNIT_NAME,
+			"systemctl restart " + TUNE_UNIT_NAME, // write the sysfs items right now
+		} {
+			if unitErr != nil {
+				break
+			} else if out, err := s.execute(ctx, command); err != nil {
+				unitErr = fmt.Errorf("%s: %s", command, out)
+			}
+		}
+	}
+
+	for _, item := range pending {
+		var err error
+		switch item.Kind {
+		case TUNE_KIND_SYSCTL:
+			err = sysctlErr
+		case TUNE_KIND_ULIMIT:
+			err = limitsErr // take effect for new sessions
+		case TUNE_KIND_SYSFS:
+			err = unitErr
+			if err == nil {
+				err = s.verify(ctx, item)
+			}
+		case TUNE_KIND_FUSE:
+			err = s.run(ctx, fmt.Sprintf("bash -c 'echo %s >> %s'", item.Key, os.GetFuseConfPath()))
+		case TUNE_KIND_IRQBALANCE:
+			err = s.enableIrqbalance(ctx)
+		}
+		s.record(item, befores[item.Id()], item.Value,
+			utils.Choose(err == nil, TUNE_ACTION_CHANGED, TUNE_ACTION_FAILED), err)
+	}
+}
+
+func (s *step2TuneHost) run(ctx *context.Context, command string) error {
+	if out, err := s.execute(ctx, command); err != nil {
+		return fmt.Errorf("%s: %s", command, out)
+	}
+	return nil
+}
+
+// the unit ignores the failure of writing sysfs, e.g. the scheduler which device not supports
+func (s *step2TuneHost) verify(ctx *context.Context, item TuneItem) error {
+	value, _, err := s.current(ctx, item)
+	if err != nil {
+		return err
+	} else if value != item.Value {
+		return fmt.Errorf("%s is %s after tuning", item.Key, value)
+	}
+	return nil
+}
+
+func (s *step2TuneHost) enableIrqbalance(ctx *context.Context) error {
+	if _, err := s.execute(ctx, "systemctl cat "+TUNE_IRQBALANCE); err != nil {
+		command, err := s.release.InstallPackageCommand(TUNE_IRQBALANCE)
+		if err != nil {
+			return err
+		} else if err := s.run(ctx, command); err != nil {
+			return err
+		}
+	}
+	return s.run(ctx, "systemctl enable --now "+TUNE_IRQBALANCE)
+}
+
+// restore sets the item back to the original value
+func (s *step2TuneHost) restore(ctx *context.Context, item TuneItem) error {
+	switch item.Kind {
+	case TUNE_KIND_SYSCTL:
+		return s.run(ctx, fmt.Sprintf("sysctl -w %s=\"%s\"", item.Key, item.Value))
+	case TUNE_KIND_SYSFS:
+		return s.run(ctx, fmt.Sprintf("bash -c 'echo %s > %s'", item.Value, item.Key))
+	case TUNE_KIND_FUSE:
+		if item.Value == TUNE_NO {
+			return s.run(ctx, fmt.Sprintf("sed -i '/^%s/d' %s", item.Key, os.GetFuseConfPath()))
+		}
+	case TUNE_KIND_IRQBALANCE:
+		if item.Value != TUNE_SERVICE_ACTIVE {
+			return s.run(ctx, "systemctl disable --now "+TUNE_IRQBALANCE)
+		}
+	}
+	// e.g. the limit is still overrided by other files after our limits file removed
+	return errRevertUnsupported
+}
+
+// revert restores all items saved in snapshot, regardless of the current profile
+func (s *step2TuneHost) revert(ctx *context.Context, snapshot TuneSnapshot) {
+	// (1) remove the persisted configures, the unit is disabled before its file removed
+	s.execute(ctx, "systemctl disable "+TUNE_UNIT_NAME) // maybe not installed
+	for _, file := range []string{sysctlConfPath(), limitsConfPath(), tuneUnitPath()} {
+		if out, err := ctx.Module().Shell().Remove(file).AddOption("-f").Execute(s.execOptions); err != nil {
+			s.record(TuneItem{Kind: "file", Key: file}, "", "", TUNE_ACTION_FAILED, fmt.Errorf("%s", out))
+		}
+	}
+	if err := s.run(ctx, "systemctl daemon-reload"); err != nil {
+		s.record(TuneItem{Kind: "file", Key: tuneUnitPath()}, "", "", TUNE_ACTION_FAILED, err)
+	}
+
+	// (2) restore the original values
+	ids := []string{}
+	for id := range snapshot.Values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		kind, key, _ := strings.Cut(id, ":")
+		item := TuneItem{Kind: kind, Key: key, Value: snapshot.Values[id]}
+		before, _, err := s.current(ctx, item)
+		if err != nil {
+			s.record(item, "-", item.Value, TUNE_ACTION_FAILED, err)
+			continue
+		} else if before == item.Value {
+			s.record(item, before, item.Value, TUNE_ACTION_OK, nil)
+			continue
+		}
+
+		err = s.restore(ctx, item)
+		if err == errRevertUnsupported {
+			s.record(item, before, item.Value, TUNE_ACTION_SKIPPED, nil)
+			continue
+		}
+		s.record(item, before, item.Value,
+			utils.Choose(err == nil, TUNE_ACTION_REVERTED, TUNE_ACTION_FAILED), err)
+	}
+}
+
+func (s *step2TuneHost) Execute(ctx *context.Context) error {
+	out, err := ctx.Module().Shell().Cat(os.GetOSReleasePath()).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_CONCATENATE_FILE_FAILED.S(out)
+	}
+	s.release = os.ParseOSRelease(out)
+
+	var snapshot *TuneSnapshot
+	if v := s.memStorage.Get(comm.KEY_TUNE_SNAPSHOTS); v != nil {
+		if ss, ok := v.(map[string]TuneSnapshot)[s.host]; ok {
+			snapshot = &ss
+		}
+	}
+
+	if s.mode == TUNE_MODE_REVERT {
+		if snapshot == nil {
+			return errno.ERR_TUNE_SNAPSHOT_NOT_FOUND.F("host: %s", s.host)
+		}
+		s.revert(ctx, *snapshot)
+	} else {
+		// (1) get current values, the item which is not supported by kernel is skipped
+		items := []TuneItem{}
+		befores := map[string]string{}
+		origins := map[string]string{}
+		for _, item := range GetTuneProfile(s.roles) {
+			before, values, err := s.current(ctx, item)
+			if err != nil && (item.Kind == TUNE_KIND_SYSCTL || item.Kind == TUNE_KIND_SYSFS) {
+				s.record(item, "-", item.Value, TUNE_ACTION_UNSUPPORTED, nil)
+				continue
+			} else if err != nil {
+				s.record(item, "-", item.Value, TUNE_ACTION_FAILED, err)
+				continue
+			}
+			items = append(items, item)
+			befores[item.Id()] = before
+			for id, value := range values {
+				origins[id] = value
+			}
+		}
+
+		// (2) check or apply
+		if s.mode == TUNE_MODE_CHECK {
+			for _, item := range items {
+				before := befores[item.Id()]
+				s.record(item, before, item.Value,
+					utils.Choose(before == item.Value, TUNE_ACTION_OK, TUNE_ACTION_DRIFT), nil)
+			}
+		} else {
+			// keep the original values of first tuning, only the new items are added
+			if snapshot == nil {
+				snapshot = &TuneSnapshot{Host: s.host, Values: map[string]string{}, CreatedAt: time.Now()}
+			}
+			for id, value := range origins {
+				if _, ok := snapshot.Values[id]; !ok {
+					snapshot.Values[id] = value
+				}
+			}
+			s.apply(ctx, items, befores)
+		}
+	}
+
+	s.memStorage.TX(func(kv *utils.SafeMap) error {
+		results := map[string][]TuneResult{}
+		if v := kv.Get(comm.KEY_ALL_TUNE_RESULTS); v != nil {
+			results = v.(map[string][]TuneResult)
+		}
+		results[s.host] = s.results
+		kv.Set(comm.KEY_ALL_TUNE_RESULTS, results)
+
+		if snapshot != nil && s.mode == TUNE_MODE_APPLY {
+			snapshots := map[string]TuneSnapshot{}
+			if v := kv.Get(comm.KEY_TUNE_SNAPSHOTS); v != nil {
+				snapshots = v.(map[string]TuneSnapshot)
+			}
+			snapshots[s.host] = *snapshot
+			kv.Set(comm.KEY_TUNE_SNAPSHOTS, snapshots)
+		}
+		return nil
+	})
+	return nil
+}
+
+func NewTuneHostTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	mode := dingocli.MemStorage().Get(comm.KEY_TUNE_MODE).(string)
+	roles := dingocli.MemStorage().Get(comm.KEY_TUNE_HOST_ROLES).(map[string][]string)[dc.GetHost()]
+	subname := fmt.Sprintf("host=%s roles=%s mode=%s", dc.GetHost(), strings.Join(roles, ","), mode)
+	t := task.NewTask("Tune Host", subname, hc.GetSSHConfig())
+	t.AddStep(&step2TuneHost{
+		host:        dc.GetHost(),
+		roles:       roles,
+		mode:        mode,
+		memStorage:  dingocli.MemStorage(),
+		execOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+func SaveTuneSnapshot(s *storage.Storage, snapshot TuneSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return s.SetTuneSnapshot(snapshot.Host, string(data))
+}
+
+// LoadTuneSnapshot returns the snapshot of host, nil if it's never tuned
+func LoadTuneSnapshot(s *storage.Storage, host string) (*TuneSnapshot, error) {
+	items, err := s.GetTuneSnapshot(host)
+	if err != nil {
+		return nil, err
+	} else if len(items) == 0 {
+		return nil, nil
+	}
+
+	snapshot := &TuneSnapshot{}
+	if err := json.Unmarshal([]byte(items[0].Data), snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
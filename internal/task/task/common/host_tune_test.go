@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dingodb/dingocli/internal/configure/topology"
+)
+
+func profileValues(items []TuneItem) map[string]string {
+	values := map[string]string{}
+	for _, item := range items {
+		values[item.Id()] = item.Value
+	}
+	return values
+}
+
+/*
+ * TestGetTuneProfile, run: go test ./internal/task/task/common -run ^TestGetTuneProfile$
+ */
+func TestGetTuneProfile(t *testing.T) {
+	swappiness := TuneItem{Kind: TUNE_KIND_SYSCTL, Key: "vm.swappiness"}.Id()
+	nofile := TuneItem{Kind: TUNE_KIND_ULIMIT, Key: TUNE_ULIMIT_NOFILE}.Id()
+	thp := TuneItem{Kind: TUNE_KIND_SYSFS, Key: TUNE_THP_PATH}.Id()
+
+	tests := []struct {
+		name   string
+		roles  []string
+		expect map[string]string // overrided items, others keep the base value
+	}{
+		{"no roles", nil, map[string]string{}},
+		{"role without profile", []string{topology.ROLE_FS_MDS_CLI}, map[string]string{}},
+		{"mds", []string{topology.ROLE_FS_MDS}, map[string]string{nofile: "1048576"}},
+		{
+			"store",
+			[]string{topology.ROLE_STORE},
+			map[string]string{swappiness: "1", nofile: "1048576", thp: "never"},
+		},
+		{
+			"mds and store",
+			[]string{topology.ROLE_STORE, topology.ROLE_FS_MDS},
+			map[string]string{swappiness: "1", nofile: "1048576", thp: "never"},
+		},
+	}
+
+	base := profileValues(TUNE_PROFILE_BASE)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := GetTuneProfile(tt.roles)
+			if len(items) != len(TUNE_PROFILE_BASE) {
+				t.Fatalf("expect %d items, got %d", len(TUNE_PROFILE_BASE), len(items))
+			}
+			for i, item := range items {
+				if item.Id() != TUNE_PROFILE_BASE[i].Id() {
+					t.Errorf("expect item %s at %d, got %s", TUNE_PROFILE_BASE[i].Id(), i, item.Id())
+				}
+			}
+
+			values := profileValues(items)
+			for id, value := range base {
+				if expect, ok := tt.expect[id]; ok {
+					value = expect
+				}
+				if values[id] != value {
+					t.Errorf("expect %s=%s, got %s", id, value, values[id])
+				}
+			}
+		})
+	}
+}
+
+func TestGetTuneProfileConflict(t *testing.T) {
+	nofile := TuneItem{Kind: TUNE_KIND_ULIMIT, Key: TUNE_ULIMIT_NOFILE}.Id()
+	thp := TuneItem{Kind: TUNE_KIND_SYSFS, Key: TUNE_THP_PATH}.Id()
+	origin := TUNE_PROFILE_ROLES
+	defer func() { TUNE_PROFILE_ROLES = origin }()
+	TUNE_PROFILE_ROLES = map[string][]TuneItem{
+		"a": {{TUNE_KIND_ULIMIT, TUNE_ULIMIT_NOFILE, "1048576"}, {TUNE_KIND_SYSFS, TUNE_THP_PATH, "never"}},
+		"b": {{TUNE_KIND_ULIMIT, TUNE_ULIMIT_NOFILE, "2097152"}, {TUNE_KIND_SYSFS, TUNE_THP_PATH, "madvise"}},
+		"c": {{TUNE_KIND_SYSCTL, "vm.min_free_kbytes", "1048576"}},
+		"d": {{TUNE_KIND_SYSCTL, "vm.min_free_kbytes", "524288"}, {TUNE_KIND_SYSFS, TUNE_THP_PATH, "always"}},
+		"e": {{TUNE_KIND_SYSFS, TUNE_THP_PATH, "never"}},
+	}
+
+	tests := []struct {
+		name   string
+		roles  []string
+		expect map[string]string
+	}{
+		{"larger nofile wins", []string{"b", "a"}, map[string]string{nofile: "2097152", thp: "never"}},
+		{"never wins", []string{"e", "d"}, map[string]string{thp: "never"}},
+		{"first role wins", []string{"d", "c"}, map[string]string{"sysctl:vm.min_free_kbytes": "1048576", thp: "always"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roles := append([]string{}, tt.roles...)
+			values := profileValues(GetTuneProfile(tt.roles))
+			for id, value := range tt.expect {
+				if values[id] != value {
+					t.Errorf("expect %s=%s, got %s", id, value, values[id])
+				}
+			}
+			if !reflect.DeepEqual(roles, tt.roles) {
+				t.Errorf("roles are modified: %v", tt.roles)
+			}
+		})
+	}
+}
+
+func TestParseSysfsValues(t *testing.T) {
+	out := `/sys/block/sda/queue/scheduler:[mq-deadline] kyber bfq none
+/sys/block/sdb/queue/scheduler:mq-deadline kyber [bfq] none
+/sys/kernel/mm/transparent_hugepage/enabled:always madvise [never]
+/sys/devices/system/cpu/cpu0/power/energy_perf_bias:6
+`
+	expect := map[string]string{
+		"/sys/block/sda/queue/scheduler":                      "mq-deadline",
+		"/sys/block/sdb/queue/scheduler":                      "bfq",
+		"/sys/kernel/mm/transparent_hugepage/enabled":         "never",
+		"/sys/devices/system/cpu/cpu0/power/energy_perf_bias": "6",
+	}
+	values := parseSysfsValues(out)
+	if !reflect.DeepEqual(values, expect) {
+		t.Errorf("expect %v, got %v", expect, values)
+	}
+	if summary := summarizeValues(values); summary != "6,bfq,mq-deadline,never" {
+		t.Errorf("expect summary 6,bfq,mq-deadline,never, got %s", summary)
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/netperf"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	NETPERF_BINARY_NAME = "dingo"
+	NETPERF_SERVER_LOG  = "server.log"
+
+	// HOME is redirected to the working directory to avoid leaving ~/.dingo on host
+	COMMAND_START_NETPERF_SERVER = "bash -c 'HOME=%[1]s nohup %[1]s/%[2]s hosts netperf-agent --listen :%[3]d --timeout %[4]s > %[1]s/%[5]s 2>&1 &'"
+	COMMAND_RUN_NETPERF_CLIENT   = "bash -c 'HOME=%[1]s %[1]s/%[2]s hosts netperf-agent --connect %[3]s --pings %[4]d --duration %[5]s'"
+	COMMAND_STOP_NETPERF_SERVER  = "pkill -f %s/%s"
+	COMMAND_CHECK_NETPERF_SERVER = "pgrep -f %s/%s"
+	COMMAND_CAT_NETPERF_LOG      = "cat %s/%s"
+
+	// the server is started in background, so we wait it listening
+	NETPERF_SERVER_FILTER_SPORT = "( sport = :%d )"
+	NETPERF_SERVER_CHECK_TIMES  = 10
+	NETPERF_SERVER_CHECK_DELAY  = 500 * time.Millisecond
+)
+
+var (
+	// uname -m -> GOARCH
+	MACHINE_ARCHS = map[string]string{
+		"x86_64":  "amd64",
+		"aarch64": "arm64",
+		"arm64":   "arm64",
+	}
+)
+
+type (
+	NetperfOptions struct {
+		Binary   string // the local dingo binary
+		Dir      string // the remote working directory
+		Port     int
+		Pings    int
+		Duration time.Duration
+		Timeout  time.Duration     // the server exits after timeout
+		Targets  map[string]string // host -> listen ip
+	}
+
+	step2CheckMachineArch struct {
+		host        string
+		execOptions module.ExecOptions
+	}
+
+	step2UploadNetperfBinary struct {
+		options     NetperfOptions
+		execOptions module.ExecOptions
+	}
+
+	step2WaitNetperfServer struct {
+		host        string
+		options     NetperfOptions
+		execOptions module.ExecOptions
+	}
+
+	step2RunNetperfClient struct {
+		host        string
+		options     NetperfOptions
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+)
+
+// NewNetperfDir returns an unique working directory of this test on hosts
+func NewNetperfDir() string {
+	return path.Join(step.TEMP_DIR, fmt.Sprintf("dingo-netperf-%d", time.Now().UnixNano()))
+}
+
+func getNetperfOptions(dingocli *cli.DingoCli) NetperfOptions {
+	return dingocli.MemStorage().Get(comm.KEY_NETPERF_OPTIONS).(NetperfOptions)
+}
+
+// the server and client are run by SSH user in temporary directory
+func netperfExecOptions(dingocli *cli.DingoCli) module.ExecOptions {
+	options := dingocli.ExecOptions()
+	options.ExecWithSudo = false
+	return options
+}
+
+func (s *step2CheckMachineArch) Execute(ctx *context.Context) error {
+	out, err := ctx.Module().Shell().UnixName().AddOption("-m").Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UNKNOWN.S(out)
+	}
+
+	machine := strings.TrimSpace(out)
+	if MACHINE_ARCHS[machine] != runtime.GOARCH {
+		return errno.ERR_NETPERF_ARCH_MISMATCH.
+			F("host=%s machine=%s, local=%s", s.host, machine, runtime.GOARCH)
+	}
+	return nil
+}
+
+func (s *step2UploadNetperfBinary) Execute(ctx *context.Context) error {
+	remotePath := path.Join(s.options.Dir, NETPERF_BINARY_NAME)
+	if err := ctx.Module().File().Upload(s.options.Binary, remotePath); err != nil {
+		return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+	}
+	out, err := ctx.Module().Shell().Command("chmod +x " + remotePath).Execute(s.execOptions)
+	if err != nil {
+		return errno.ERR_UNKNOWN.S(out)
+	}
+	return nil
+}
+
+// the server exits at once if it failed to listen, e.g. port already in use,
+// so the delay before every check lets the failed server exit
+func (s *step2WaitNetperfServer) Execute(ctx *context.Context) error {
+	for i := 0; i < NETPERF_SERVER_CHECK_TIMES; i++ {
+		time.Sleep(NETPERF_SERVER_CHECK_DELAY)
+		cmd := ctx.Module().Shell().SocketStatistics(fmt.Sprintf(NETPERF_SERVER_FILTER_SPORT, s.options.Port))
+		cmd.AddOption("--no-header")
+		cmd.AddOption("--listening")
+		out, err := cmd.Execute(s.execOptions)
+		if err == nil && len(strings.TrimSpace(out)) > 0 {
+			return nil
+		}
+
+		command := fmt.Sprintf(COMMAND_CHECK_NETPERF_SERVER, s.options.Dir, NETPERF_BINARY_NAME)
+		if _, err := ctx.Module().Shell().Command(command).Execute(s.execOptions); err != nil {
+			break // server exited
+		}
+	}
+
+	log, _ := ctx.Module().Shell().
+		Command(fmt.Sprintf(COMMAND_CAT_NETPERF_LOG, s.options.Dir, NETPERF_SERVER_LOG)).
+		Execute(s.execOptions)
+	return errno.ERR_START_NETPERF_SERVER_FAILED.
+		F("host=%s port=%d not listening: %s", s.host, s.options.Port, strings.TrimSpace(log))
+}
+
+func (s *step2RunNetperfClient) Execute(ctx *context.Context) error {
+	hosts := []string{}
+	for host := range s.options.Targets {
+		if host != s.host {
+			hosts = append(hosts, host)
+		}
+	}
+	sort.Strings(hosts)
+
+	// one target by one to avoid the links affecting each other
+	results := map[string]netperf.Result{}
+	for _, host := range hosts {
+		addr := fmt.Sprintf("%s:%d", s.options.Targets[host], s.options.Port)
+		command := fmt.Sprintf(COMMAND_RUN_NETPERF_CLIENT, s.options.Dir, NETPERF_BINARY_NAME,
+			addr, s.options.Pings, s.options.Duration)
+		out, err := ctx.Module().Shell().Command(command).Execute(s.execOptions)
+
+		result := netperf.Result{Target: addr}
+		if err != nil {
+			result.Error = strings.TrimSpace(out)
+		} else if err := json.Unmarshal([]byte(out), &result); err != nil {
+			result.Error = fmt.Sprintf("unrecognized output: %s", strings.TrimSpace(out))
+		}
+		results[host] = result
+	}
+
+	s.memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]map[string]netperf.Result{}
+		if v := kv.Get(comm.KEY_ALL_NETPERF_RESULTS); v != nil {
+			m = v.(map[string]map[string]netperf.Result)
+		}
+		m[s.host] = results
+		kv.Set(comm.KEY_ALL_NETPERF_RESULTS, m)
+		return nil
+	})
+	return nil
+}
+
+func NewStartNetperfServerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	options := getNetperfOptions(dingocli)
+	subname := fmt.Sprintf("host=%s listen=%s:%d", dc.GetHost(), options.Targets[dc.GetHost()], options.Port)
+	t := task.NewTask("Start Netperf Server", subname, hc.GetSSHConfig())
+
+	execOptions := netperfExecOptions(dingocli)
+	t.AddStep(&step2CheckMachineArch{
+		host:        dc.GetHost(),
+		execOptions: execOptions,
+	})
+	t.AddStep(&step.CreateDirectory{
+		Paths:       []string{options.Dir},
+		ExecOptions: execOptions,
+	})
+	t.AddStep(&step2UploadNetperfBinary{
+		options:     options,
+		execOptions: execOptions,
+	})
+	var out string
+	var success bool
+	t.AddStep(&step.Command{
+		Command: fmt.Sprintf(COMMAND_START_NETPERF_SERVER, options.Dir, NETPERF_BINARY_NAME,
+			options.Port, options.Timeout, NETPERF_SERVER_LOG),
+		Out:         &out,
+		Success:     &success,
+		ExecOptions: execOptions,
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: func(ctx *context.Context) error {
+			if !success {
+				return errno.ERR_START_NETPERF_SERVER_FAILED.S(out)
+			}
+			return nil
+		},
+	})
+	t.AddStep(&step2WaitNetperfServer{
+		host:        dc.GetHost(),
+		options:     options,
+		execOptions: execOptions,
+	})
+
+	return t, nil
+}
+
+func NewRunNetperfClientTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	options := getNetperfOptions(dingocli)
+	subname := fmt.Sprintf("host=%s targets=%d duration=%s", dc.GetHost(), len(options.Targets)-1, options.Duration)
+	t := task.NewTask("Run Netperf Client", subname, hc.GetSSHConfig())
+	t.AddStep(&step2RunNetperfClient{
+		host:        dc.GetHost(),
+		options:     options,
+		memStorage:  dingocli.MemStorage(),
+		execOptions: netperfExecOptions(dingocli),
+	})
+
+	return t, nil
+}
+
+func NewStopNetperfServerTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	options := getNetperfOptions(dingocli)
+	subname := fmt.Sprintf("host=%s", dc.GetHost())
+	t := task.NewTask("Stop Netperf Server", subname, hc.GetSSHConfig())
+
+	// the server may be not started, so we ignore the error
+	var success bool
+	execOptions := netperfExecOptions(dingocli)
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf(COMMAND_STOP_NETPERF_SERVER, options.Dir, NETPERF_BINARY_NAME),
+		Success:     &success,
+		ExecOptions: execOptions,
+	})
+	t.AddStep(&step.RemoveFile{
+		Files:       []string{options.Dir},
+		ExecOptions: execOptions,
+	})
+
+	return t, nil
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	FORMAT_CONTAINER_STATS    = `"{{.CPUPerc}}|{{.MemUsage}}|{{.MemPerc}}"`
+	FORMAT_CONTAINER_RESTARTS = `"{{.RestartCount}}"`
+
+	SERVICE_TOP_ABSENT = "-"
+)
+
+type (
+	step2GetContainerUsage struct {
+		containerId string
+		status      *string
+		cpu         *string
+		memUsage    *string
+		memPercent  *string
+		restarts    *string
+		execOptions module.ExecOptions
+	}
+
+	step2FormatServiceTop struct {
+		dc          *topology.DeployConfig
+		serviceId   string
+		containerId string
+		status      *string
+		isLeader    *bool
+		cpu         *string
+		memUsage    *string
+		memPercent  *string
+		restarts    *string
+		memStorage  *utils.SafeMap
+	}
+
+	// ServiceTop is the snapshot of one service shown by `cluster top`
+	ServiceTop struct {
+		ServiceStatus
+		CPU        string
+		MemUsage   string
+		MemPercent string
+		Restarts   string
+		Online     string // mds online state from mds cluster, filled by caller
+	}
+)
+
+func setServiceTop(memStorage *utils.SafeMap, id string, top ServiceTop) {
+	memStorage.TX(func(kv *utils.SafeMap) error {
+		m := map[string]ServiceTop{}
+		v := kv.Get(comm.KEY_ALL_SERVICE_TOP)
+		if v != nil {
+			m = v.(map[string]ServiceTop)
+		}
+		m[id] = top
+		kv.Set(comm.KEY_ALL_SERVICE_TOP, m)
+		return nil
+	})
+}
+
+func (s *step2GetContainerUsage) Execute(ctx *context.Context) error {
+	*s.cpu, *s.memUsage, *s.memPercent, *s.restarts =
+		SERVICE_TOP_ABSENT, SERVICE_TOP_ABSENT, SERVICE_TOP_ABSENT, SERVICE_TOP_ABSENT
+	if len(*s.status) == 0 || s.containerId == comm.CLEANED_CONTAINER_ID {
+		return nil
+	}
+
+	// restart count is available even the container is exited
+	cli := ctx.Module().DockerCli().InspectContainer(s.containerId)
+	cli.AddOption("--format=%s", FORMAT_CONTAINER_RESTARTS)
+	out, err := cli.Execute(s.execOptions)
+	if err == nil {
+		*s.restarts = strings.TrimSpace(out)
+	}
+
+	if !strings.HasPrefix(*s.status, "Up") {
+		return nil
+	}
+
+	// e.g: 1.25%|105.3MiB / 15.5GiB|0.66%
+	cli = ctx.Module().DockerCli().ContainerStats(s.containerId)
+	cli.AddOption("--no-stream")
+	cli.AddOption("--format=%s", FORMAT_CONTAINER_STATS)
+	out, err = cli.Execute(s.execOptions)
+	if err != nil {
+		return nil
+	}
+	items := strings.Split(strings.TrimSpace(out), "|")
+	if len(items) == 3 {
+		*s.cpu = strings.TrimSpace(items[0])
+		*s.memUsage = strings.TrimSpace(items[1])
+		*s.memPercent = strings.TrimSpace(items[2])
+	}
+	return nil
+}
+
+func (s *step2FormatServiceTop) Execute(ctx *context.Context) error {
+	status := *s.status
+	if s.containerId == comm.CLEANED_CONTAINER_ID { // container cleaned
+		status = comm.SERVICE_STATUS_CLEANED
+	} else if len(status) == 0 { // container losed
+		status = comm.SERVICE_STATUS_LOSED
+	}
+
+	dc := s.dc
+	id := s.serviceId
+	setServiceTop(s.memStorage, id, ServiceTop{
+		ServiceStatus: ServiceStatus{
+			Id:          id,
+			ParentId:    dc.GetParentId(),
+			Role:        dc.GetRole(),
+			Host:        dc.GetHost(),
+			Instances:   fmt.Sprintf("1/%d", dc.GetInstances()),
+			ContainerId: tui.TrimContainerId(s.containerId),
+			IsLeader:    *s.isLeader,
+			Status:      status,
+			Config:      dc,
+		},
+		CPU:        *s.cpu,
+		MemUsage:   *s.memUsage,
+		MemPercent: *s.memPercent,
+		Restarts:   *s.restarts,
+		Online:     SERVICE_TOP_ABSENT,
+	})
+	return nil
+}
+
+func NewGetServiceTopTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if dingocli.IsSkip(dc) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Get Service Top", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var status, cpu, memUsage, memPercent, restarts string
+	var isLeader bool
+	t.AddStep(&step.ListContainers{
+		ShowAll:     true,
+		Format:      `"{{.Status}}"`,
+		Filter:      fmt.Sprintf("id=%s", containerId),
+		Out:         &status,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: TrimContainerStatus(&status),
+	})
+	t.AddStep(&step2GetLeader{
+		dc:          dc,
+		containerId: containerId,
+		status:      &status,
+		isLeader:    &isLeader,
+		execOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step2GetContainerUsage{
+		containerId: containerId,
+		status:      &status,
+		cpu:         &cpu,
+		memUsage:    &memUsage,
+		memPercent:  &memPercent,
+		restarts:    &restarts,
+		execOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step2FormatServiceTop{
+		dc:          dc,
+		serviceId:   serviceId,
+		containerId: containerId,
+		status:      &status,
+		isLeader:    &isLeader,
+		cpu:         &cpu,
+		memUsage:    &memUsage,
+		memPercent:  &memPercent,
+		restarts:    &restarts,
+		memStorage:  dingocli.MemStorage(),
+	})
+
+	return t, nil
+}
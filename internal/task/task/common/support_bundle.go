@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+const (
+	URL_DINGOFS_METRIC_VARS = "http://%s:%d/vars"
+
+	BUNDLE_DIR_HOSTS    = "hosts"
+	BUNDLE_DIR_SERVICES = "services"
+
+	// at most 10KB output of failed command is recorded in manifest
+	BUNDLE_MAX_ERROR_LENGTH = 10 * 1024
+
+	REDACTED_SECRET = "******"
+	SECRET_KEY      = `(?:[\w.\-]*[_.\-])?(?:ak|sk|password|passwd|passphrase|secret|secret_key|access_key|token)`
+)
+
+var (
+	// yaml: s3.sk: xxx, etcd.auth.password: xxx, private_key_passphrase: xxx
+	REGEX_SECRET_ITEM = regexp.MustCompile(
+		`(?im)^(\s*(?:-\s+)?[\w.\-]*?(?:\bak|\bsk|password|passwd|passphrase|secret|secret_key|access_key|token)\s*:\s*)\S.*$`)
+
+	// json: "s3.sk": "xxx"
+	REGEX_SECRET_JSON_ITEM = regexp.MustCompile(`(?i)("` + SECRET_KEY + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// conf, flags and environments: s3.sk=xxx, --s3_sk=xxx, "S3_SK=xxx"
+	REGEX_SECRET_KV_ITEM = regexp.MustCompile(`(?i)(^|[^\w.\-])(` + SECRET_KEY + `\s*=\s*)[^\s"',;]+`)
+)
+
+/*
+ * support bundle layout:
+ *   dingo-support-bundle-<cluster>-<time>
+ *   ├── manifest.json
+ *   ├── topology.yaml
+ *   ├── hosts.yaml
+ *   ├── hosts
+ *   │   └── <host>
+ *   │       ├── uname.txt
+ *   │       ├── df.txt
+ *   │       └── dmesg.txt
+ *   └── services
+ *       └── <role>_<host>_<serviceId>
+ *           ├── inspect.json
+ *           ├── container.log
+ *           ├── logs.txt
+ *           ├── cores.txt
+ *           ├── vars.txt
+ *           └── configs
+ *               └── mds.conf
+ */
+type (
+	BundleItem struct {
+		Host    string `json:"host"`
+		Service string `json:"service,omitempty"`
+		Role    string `json:"role,omitempty"`
+		Item    string `json:"item"`
+		File    string `json:"file,omitempty"`
+		Error   string `json:"error,omitempty"`
+	}
+
+	SupportBundle struct {
+		Dir      string
+		LogTail  int    // the last N lines of each log
+		LogSince string // only collect container logs since
+		mutex    sync.Mutex
+		items    []BundleItem
+	}
+
+	bundleOutput struct {
+		out     string
+		success bool
+	}
+
+	step2SaveBundleItem struct {
+		bundle *SupportBundle
+		item   BundleItem
+		output *bundleOutput
+	}
+)
+
+func NewSupportBundle(dir string, logTail int, logSince string) *SupportBundle {
+	return &SupportBundle{
+		Dir:      dir,
+		LogTail:  logTail,
+		LogSince: logSince,
+		items:    []BundleItem{},
+	}
+}
+
+func (b *SupportBundle) Record(item BundleItem) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.items = append(b.items, item)
+}
+
+// Items returns all collected and failed items sorted by host, service and item
+func (b *SupportBundle) Items() []BundleItem {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	items := append([]BundleItem{}, b.items...)
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Host != items[j].Host {
+			return items[i].Host < items[j].Host
+		} else if items[i].Service != items[j].Service {
+			return items[i].Service < items[j].Service
+		}
+		return items[i].Item < items[j].Item
+	})
+	return items
+}
+
+// RedactSecrets masks the value of secret items (e.g. s3 ak/sk, password)
+// in yaml, json, conf and command line flags
+func RedactSecrets(data string) string {
+	data = REGEX_SECRET_ITEM.ReplaceAllString(data, "${1}"+REDACTED_SECRET)
+	data = REGEX_SECRET_JSON_ITEM.ReplaceAllString(data, "${1}\""+REDACTED_SECRET+"\"")
+	return REGEX_SECRET_KV_ITEM.ReplaceAllString(data, "${1}${2}"+REDACTED_SECRET)
+}
+
+func (b *SupportBundle) Save(file, content string) error {
+	path := filepath.Join(b.Dir, file)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return utils.WriteFile(path, content, 0644)
+}
+
+// failure of collecting one item should not stop collecting others,
+// and all collected content (configs, inspect, logs...) is redacted before saved
+func (s *step2SaveBundleItem) Execute(ctx *context.Context) error {
+	item := s.item
+	out := RedactSecrets(s.output.out)
+	if !s.output.success {
+		item.Error = out
+		if len(item.Error) == 0 {
+			item.Error = "execute command failed"
+		} else if len(item.Error) > BUNDLE_MAX_ERROR_LENGTH {
+			item.Error = item.Error[:BUNDLE_MAX_ERROR_LENGTH]
+		}
+		item.File = ""
+	} else if err := s.bundle.Save(item.File, out+"\n"); err != nil {
+		item.Error = err.Error()
+		item.File = ""
+	}
+	s.bundle.Record(item)
+	return nil
+}
+
+func (b *SupportBundle) step(item BundleItem, output *bundleOutput) task.Step {
+	return &step2SaveBundleItem{bundle: b, item: item, output: output}
+}
+
+func getSupportBundle(dingocli *cli.DingoCli) *SupportBundle {
+	return dingocli.MemStorage().Get(comm.KEY_SUPPORT_BUNDLE).(*SupportBundle)
+}
+
+func NewCollectHostInfoTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := getSupportBundle(dingocli)
+	subname := fmt.Sprintf("host=%s", dc.GetHost())
+	t := task.NewTask("Collect Host Info", subname, hc.GetSSHConfig())
+
+	dmesg := "dmesg -T"
+	if bundle.LogTail > 0 {
+		dmesg = fmt.Sprintf("bash -c 'dmesg -T | tail -n %d'", bundle.LogTail)
+	}
+	dir := filepath.Join(BUNDLE_DIR_HOSTS, dc.GetHost())
+	items := []struct {
+		name    string
+		file    string
+		command string
+	}{
+		{"uname", "uname.txt", "uname -a"},
+		{"df", "df.txt", "df -hT"},
+		{"dmesg", "dmesg.txt", dmesg},
+	}
+	for _, item := range items {
+		output := &bundleOutput{}
+		t.AddStep(&step.Command{
+			Command:     item.command,
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(bundle.step(BundleItem{
+			Host: dc.GetHost(),
+			Item: item.name,
+			File: filepath.Join(dir, item.file),
+		}, output))
+	}
+
+	return t, nil
+}
+
+/*
+ * glog writes the WARNING/ERROR/FATAL logs into INFO file too,
+ * so we skip them to avoid duplicate logs.
+ */
+func tailLogFiles(logDir string, tail int) string {
+	lines := "+1"
+	if tail > 0 {
+		lines = fmt.Sprintf("%d", tail)
+	}
+	return fmt.Sprintf("bash -c 'find %s -maxdepth 1 -type f"+
+		" ! -name \"*WARNING*\" ! -name \"*ERROR*\" ! -name \"*FATAL*\""+
+		" -print0 | xargs -0 -r tail -v -n %s'", logDir, lines)
+}
+
+func NewCollectServiceInfoTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := getSupportBundle(dingocli)
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		dc.GetHost(), dc.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask("Collect Service Info", subname, hc.GetSSHConfig())
+
+	dir := filepath.Join(BUNDLE_DIR_SERVICES,
+		fmt.Sprintf("%s_%s_%s", dc.GetRole(), dc.GetHost(), serviceId))
+	newItem := func(name, file string) BundleItem {
+		return BundleItem{
+			Host:    dc.GetHost(),
+			Service: serviceId,
+			Role:    dc.GetRole(),
+			Item:    name,
+			File:    filepath.Join(dir, file),
+		}
+	}
+	options := dingocli.ExecOptions()
+
+	// (1) log files under log_dir and core files on host
+	if len(dc.GetLogDir()) > 0 {
+		output := &bundleOutput{}
+		t.AddStep(&step.Command{
+			Command:     tailLogFiles(dc.GetLogDir(), bundle.LogTail),
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: options,
+		})
+		t.AddStep(bundle.step(newItem("logs", "logs.txt"), output))
+	}
+	if len(dc.GetTargetCoreDir()) > 0 {
+		output := &bundleOutput{}
+		t.AddStep(&step.Command{
+			Command:     fmt.Sprintf("ls -l --time-style=full-iso %s", dc.GetTargetCoreDir()),
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: options,
+		})
+		t.AddStep(bundle.step(newItem("cores", "cores.txt"), output))
+	}
+
+	// (2) container low-level information, logs and rendered configs
+	if containerId == comm.CLEANED_CONTAINER_ID {
+		item := newItem("container", "")
+		item.File, item.Error = "", "container is cleaned"
+		bundle.Record(item)
+		return t, nil
+	}
+
+	output := &bundleOutput{}
+	t.AddStep(&step.InspectContainer{
+		ContainerId: containerId,
+		Out:         &output.out,
+		Success:     &output.success,
+		ExecOptions: options,
+	})
+	t.AddStep(bundle.step(newItem("inspect", "inspect.json"), output))
+
+	output = &bundleOutput{}
+	t.AddStep(&step.ContainerLogs{
+		ContainerId: containerId,
+		Tail:        bundle.LogTail,
+		Since:       bundle.LogSince,
+		Out:         &output.out,
+		Success:     &output.success,
+		ExecOptions: options,
+	})
+	t.AddStep(bundle.step(newItem("container logs", "container.log"), output))
+
+	if len(dc.GetTargetCoreDir()) == 0 && len(dc.GetSourceCoreDir()) > 0 {
+		output := &bundleOutput{}
+		t.AddStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     fmt.Sprintf("ls -l --time-style=full-iso %s", dc.GetSourceCoreDir()),
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: options,
+		})
+		t.AddStep(bundle.step(newItem("cores", "cores.txt"), output))
+	}
+
+	for _, conf := range dc.GetProjectLayout().ServiceConfFiles {
+		output := &bundleOutput{}
+		t.AddStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     fmt.Sprintf("cat %s", conf.TargetPath),
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: options,
+		})
+		t.AddStep(bundle.step(newItem("config/"+conf.Name, filepath.Join("configs", conf.Name)), output))
+	}
+
+	// (3) metrics of mds
+	if dc.GetRole() == topology.ROLE_FS_MDS {
+		url := fmt.Sprintf(URL_DINGOFS_METRIC_VARS, dc.GetListenIp(), dc.GetListenDummyPort())
+		output := &bundleOutput{}
+		t.AddStep(&step.ContainerExec{
+			ContainerId: &containerId,
+			Command:     fmt.Sprintf("curl -s --connect-timeout 1 --max-time 10 %s", url),
+			Out:         &output.out,
+			Success:     &output.success,
+			ExecOptions: options,
+		})
+		t.AddStep(bundle.step(newItem("vars", "vars.txt"), output))
+	}
+
+	return t, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+ * TestRedactSecrets, run: go test ./internal/task/task/common -run ^TestRedactSecrets$
+ */
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		expect string
+	}{
+		{
+			"yaml",
+			"s3.ak: AKIAXXXX\ns3.sk: s3cr3t\ns3.endpoint: http://127.0.0.1:9000",
+			"s3.ak: ******\ns3.sk: ******\ns3.endpoint: http://127.0.0.1:9000",
+		},
+		{
+			"conf",
+			"s3.ak=AKIAXXXX\ns3.sk=s3cr3t\ndisk_cache.cache_dir=/dingofs/cache",
+			"s3.ak=******\ns3.sk=******\ndisk_cache.cache_dir=/dingofs/cache",
+		},
+		{
+			"flags",
+			"dingo-client --s3_sk=s3cr3t --mdsaddr=127.0.0.1:6700",
+			"dingo-client --s3_sk=****** --mdsaddr=127.0.0.1:6700",
+		},
+		{
+			"inspect env",
+			`"Env": ["S3_AK=AKIAXXXX", "S3_SK=s3cr3t", "DISK=/dev/sdb"],`,
+			`"Env": ["S3_AK=******", "S3_SK=******", "DISK=/dev/sdb"],`,
+		},
+		{
+			"inspect labels",
+			`"Labels": {"s3.sk": "s3cr3t", "token": "a\"b", "role": "mds"}`,
+			`"Labels": {"s3.sk": "******", "token": "******", "role": "mds"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := RedactSecrets(tt.data)
+			if out != tt.expect {
+				t.Errorf("expect %q, got %q", tt.expect, out)
+			}
+			if strings.Contains(out, "s3cr3t") || strings.Contains(out, "AKIAXXXX") {
+				t.Errorf("secret leaked: %q", out)
+			}
+		})
+	}
+}
+
+func TestSaveBundleItemRedacted(t *testing.T) {
+	bundle := NewSupportBundle(t.TempDir(), 0, "")
+	s := &step2SaveBundleItem{
+		bundle: bundle,
+		item:   BundleItem{Host: "host1", Item: "config/client.conf", File: "configs/client.conf"},
+		output: &bundleOutput{out: "s3.ak=AKIAXXXX\ns3.sk=s3cr3t", success: true},
+	}
+	if err := s.Execute(nil); err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(bundle.Dir, "configs/client.conf"))
+	if err != nil {
+		t.Fatalf("read bundle file failed: %v", err)
+	}
+	if string(data) != "s3.ak=******\ns3.sk=******\n" {
+		t.Errorf("secret is not redacted: %q", string(data))
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package monitor
+
+import (
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ALERTMANAGER_RECEIVER_NAME = "dingo"
+)
+
+type (
+	alertmanagerRoute struct {
+		Receiver       string   `yaml:"receiver"`
+		GroupBy        []string `yaml:"group_by"`
+		GroupWait      string   `yaml:"group_wait"`
+		GroupInterval  string   `yaml:"group_interval"`
+		RepeatInterval string   `yaml:"repeat_interval"`
+	}
+
+	webhookConfig struct {
+		Url          string `yaml:"url"`
+		SendResolved bool   `yaml:"send_resolved"`
+	}
+
+	emailConfig struct {
+		To           string `yaml:"to"`
+		From         string `yaml:"from"`
+		Smarthost    string `yaml:"smarthost"`
+		AuthUsername string `yaml:"auth_username,omitempty"`
+		AuthPassword string `yaml:"auth_password,omitempty"`
+		SendResolved bool   `yaml:"send_resolved"`
+	}
+
+	alertmanagerReceiver struct {
+		Name           string          `yaml:"name"`
+		WebhookConfigs []webhookConfig `yaml:"webhook_configs,omitempty"`
+		EmailConfigs   []emailConfig   `yaml:"email_configs,omitempty"`
+	}
+
+	alertmanagerConfig struct {
+		Route     alertmanagerRoute      `yaml:"route"`
+		Receivers []alertmanagerReceiver `yaml:"receivers"`
+	}
+)
+
+// genAlertmanagerConfig generates alertmanager.yml which routes all alerts to the configured receiver
+func genAlertmanagerConfig(cfg *configure.MonitorConfig) (string, error) {
+	receiver := alertmanagerReceiver{Name: ALERTMANAGER_RECEIVER_NAME}
+	switch cfg.GetAlertReceiver() {
+	case configure.RECEIVER_WEBHOOK:
+		receiver.WebhookConfigs = []webhookConfig{{
+			Url:          cfg.GetWebhookUrl(),
+			SendResolved: true,
+		}}
+	case configure.RECEIVER_EMAIL:
+		receiver.EmailConfigs = []emailConfig{{
+			To:           cfg.GetEmailTo(),
+			From:         cfg.GetEmailFrom(),
+			Smarthost:    cfg.GetEmailSmarthost(),
+			AuthUsername: cfg.GetEmailUsername(),
+			AuthPassword: cfg.GetEmailPassword(),
+			SendResolved: true,
+		}}
+	default:
+		return "", errno.ERR_INVALID_ALERT_RECEIVER.F("receiver: %s", cfg.GetAlertReceiver())
+	}
+
+	config := alertmanagerConfig{
+		Route: alertmanagerRoute{
+			Receiver:       ALERTMANAGER_RECEIVER_NAME,
+			GroupBy:        []string{"alertname", "job", "instance"},
+			GroupWait:      "30s",
+			GroupInterval:  "5m",
+			RepeatInterval: "4h",
+		},
+		Receivers: []alertmanagerReceiver{receiver},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", errno.ERR_INVALID_ALERT_RECEIVER.E(err)
+	}
+	return string(data), nil
+}
@@ -36,6 +36,9 @@ var (
 	ROLE_GRAFANA       = configure.ROLE_GRAFANA
 	ROLE_MONITOR_CONF  = configure.ROLE_MONITOR_CONF
 	ROLE_MONITOR_SYNC  = configure.ROLE_MONITOR_SYNC
+	ROLE_ALERTMANAGER  = configure.ROLE_ALERTMANAGER
+	ROLE_LOKI          = configure.ROLE_LOKI
+	ROLE_PROMTAIL      = configure.ROLE_PROMTAIL
 )
 
 func getCleanFiles(clean map[string]bool, mc *configure.MonitorConfig) []string {
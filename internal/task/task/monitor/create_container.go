@@ -60,6 +60,20 @@ func getArguments(cfg *configure.MonitorConfig) string {
 			"web.console.templates":       "/usr/share/prometheus/consoles",
 			"web.listen-address":          fmt.Sprintf(":%d", cfg.GetListenPort()),
 		}
+	case ROLE_ALERTMANAGER:
+		argsMap = map[string]interface{}{
+			"config.file":        ALERTMANAGER_CONTAINER_CONF_PATH + "/alertmanager.yml",
+			"storage.path":       "/alertmanager",
+			"web.listen-address": fmt.Sprintf(":%d", cfg.GetListenPort()),
+		}
+	case ROLE_LOKI:
+		argsMap = map[string]interface{}{
+			"config.file": LOKI_CONTAINER_CONF_PATH + "/loki.yml",
+		}
+	case ROLE_PROMTAIL:
+		argsMap = map[string]interface{}{
+			"config.file": PROMTAIL_CONTAINER_CONF_PATH + "/promtail.yml",
+		}
 	}
 	args := []string{}
 	for k, v := range argsMap {
@@ -81,7 +95,8 @@ func getMountVolumes(cfg *configure.MonitorConfig) []step.Volume {
 	case ROLE_NODE_EXPORTER:
 		volumes = append(volumes, step.Volume{
 			HostPath:      "/",
-			ContainerPath: "/host:ro,rslave",
+			ContainerPath: "/host",
+			Options:       "ro,rslave",
 		},
 			step.Volume{
 				HostPath:      "/run/udev/data",
@@ -89,7 +104,8 @@ func getMountVolumes(cfg *configure.MonitorConfig) []step.Volume {
 			},
 			step.Volume{
 				HostPath:      "/run/dbus/system_bus_socket",
-				ContainerPath: "/var/run/dbus/system_bus_socket:ro",
+				ContainerPath: "/var/run/dbus/system_bus_socket",
+				Options:       "ro",
 			})
 	case ROLE_PROMETHEUS:
 		volumes = append(volumes, step.Volume{
@@ -113,6 +129,41 @@ func getMountVolumes(cfg *configure.MonitorConfig) []step.Volume {
 			HostPath:      cfg.GetProvisionDir(),
 			ContainerPath: "/etc/grafana/provisioning",
 		})
+	case ROLE_ALERTMANAGER:
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetDataDir(),
+			ContainerPath: "/alertmanager",
+		})
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetConfDir(),
+			ContainerPath: ALERTMANAGER_CONTAINER_CONF_PATH,
+		})
+	case ROLE_LOKI:
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetDataDir(),
+			ContainerPath: LOKI_CONTAINER_DATA_PATH,
+		})
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetConfDir(),
+			ContainerPath: LOKI_CONTAINER_CONF_PATH,
+		})
+	case ROLE_PROMTAIL:
+		// only the log directories of services on the host are visible to promtail
+		for _, logDir := range cfg.GetPromtailLogDirs() {
+			volumes = append(volumes, step.Volume{
+				HostPath:      logDir,
+				ContainerPath: logDir,
+				Options:       "ro",
+			})
+		}
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetDataDir(),
+			ContainerPath: PROMTAIL_CONTAINER_DATA_PATH,
+		})
+		volumes = append(volumes, step.Volume{
+			HostPath:      cfg.GetConfDir(),
+			ContainerPath: PROMTAIL_CONTAINER_CONF_PATH,
+		})
 	case ROLE_MONITOR_SYNC:
 		volumes = append(volumes, step.Volume{
 			HostPath:      cfg.GetDataDir(),
@@ -166,7 +217,7 @@ func NewCreateContainerTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig
 	switch role {
 	case ROLE_GRAFANA:
 		paths = append(paths, cfg.GetConfDir(), cfg.GetProvisionDir()+"/datasources")
-	case ROLE_PROMETHEUS:
+	case ROLE_PROMETHEUS, ROLE_ALERTMANAGER, ROLE_LOKI, ROLE_PROMTAIL:
 		paths = append(paths, cfg.GetConfDir())
 	}
 	t.AddStep(&step.CreateDirectory{
@@ -0,0 +1,371 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package monitor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/scripts"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+	"github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+)
+
+const (
+	GRAFANA_FOLDER_UID         = "dingo"
+	GRAFANA_FOLDER_TITLE       = "Dingo"
+	GRAFANA_DEFAULT_USER       = "admin"
+	GRAFANA_DEFAULT_PASSWORD   = "admin"
+	GRAFANA_DASHBOARD_PAYLOAD  = "dashboard_payload.json"
+	GRAFANA_LEGACY_DASHBOARD   = "server_metric_zh.json"
+	GRAFANA_WAIT_RETRIES       = 10
+	GRAFANA_WAIT_RETRY_SECONDS = 3
+)
+
+type (
+	grafanaClient struct {
+		cfg         *configure.MonitorConfig
+		authFile    string
+		execOptions module.ExecOptions
+	}
+
+	step2SyncDashboards struct {
+		cfg         *configure.MonitorConfig
+		dashboards  []*configure.Dashboard
+		execOptions module.ExecOptions
+	}
+
+	step2ExportDashboards struct {
+		cfg         *configure.MonitorConfig
+		dir         string
+		memStorage  *utils.SafeMap
+		execOptions module.ExecOptions
+	}
+)
+
+// newGrafanaClient uploads the basic auth header into a private file, so that the
+// credential never shows up in the command line of curl.
+func newGrafanaClient(ctx *context.Context, cfg *configure.MonitorConfig,
+	execOptions module.ExecOptions) (*grafanaClient, error) {
+	user := utils.Choose(len(cfg.GetGrafanaUser()) > 0, cfg.GetGrafanaUser(), GRAFANA_DEFAULT_USER)
+	password := utils.Choose(len(cfg.GetGrafanaPassword()) > 0, cfg.GetGrafanaPassword(), GRAFANA_DEFAULT_PASSWORD)
+	header := fmt.Sprintf("Authorization: Basic %s\n",
+		base64.StdEncoding.EncodeToString([]byte(user+":"+password)))
+	c := &grafanaClient{
+		cfg:         cfg,
+		authFile:    utils.RandFilename(step.TEMP_DIR),
+		execOptions: execOptions,
+	}
+	scp := &step.Scp{
+		Content:     &header,
+		Mode:        0600,
+		RemotePath:  c.authFile,
+		ExecOptions: execOptions,
+	}
+	if err := scp.Execute(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *grafanaClient) close(ctx *context.Context) {
+	remove := &step.RemoveFile{Files: []string{c.authFile}, ExecOptions: c.execOptions}
+	remove.Execute(ctx)
+}
+
+func (c *grafanaClient) request(ctx *context.Context, method, api, data string, fail bool) (string, error) {
+	var out string
+	curl := &step.Curl{
+		Url:         fmt.Sprintf("'http://localhost:%d%s'", c.cfg.GetListenPort(), api),
+		Method:      method,
+		Headers:     []string{"Content-Type: application/json", "@" + c.authFile},
+		Data:        data,
+		Silent:      true,
+		Fail:        fail,
+		Out:         &out,
+		ExecOptions: c.execOptions,
+	}
+	err := curl.Execute(ctx)
+	return out, err
+}
+
+// wait for grafana service started and return the uid of datasources by plugin type
+func (c *grafanaClient) waitDatasources(ctx *context.Context) (map[string]string, error) {
+	for i := 0; i < GRAFANA_WAIT_RETRIES; i++ {
+		out, err := c.request(ctx, "GET", "/api/datasources", "", true)
+		if err == nil {
+			datasources := []map[string]interface{}{}
+			if err := json.Unmarshal([]byte(out), &datasources); err == nil {
+				uids := map[string]string{}
+				for _, datasource := range datasources {
+					typ, _ := datasource["type"].(string)
+					uid, _ := datasource["uid"].(string)
+					if _, ok := uids[typ]; !ok && len(uid) > 0 {
+						uids[typ] = uid
+					}
+				}
+				return uids, nil
+			}
+		}
+		time.Sleep(GRAFANA_WAIT_RETRY_SECONDS * time.Second)
+	}
+	return nil, errno.ERR_GRAFANA_API_REQUEST_FAILED.F("fetch grafana datasources timeout")
+}
+
+func (c *grafanaClient) ensureFolder(ctx *context.Context) error {
+	if _, err := c.request(ctx, "GET", "/api/folders/"+GRAFANA_FOLDER_UID, "", true); err == nil {
+		return nil
+	}
+	folder, _ := json.Marshal(map[string]string{"uid": GRAFANA_FOLDER_UID, "title": GRAFANA_FOLDER_TITLE})
+	_, err := c.request(ctx, "POST", "/api/folders", fmt.Sprintf("'%s'", folder), true)
+	return err
+}
+
+// genDashboardPayload generates the payload for grafana http api, dashboards which exported
+// with "__inputs" (e.g. shared via grafana.com) are imported with inputs bound to the
+// datasources, others are saved directly and resolve datasource by template variable.
+func genDashboardPayload(dashboard *configure.Dashboard, datasources map[string]string) (string, string, error) {
+	model := map[string]interface{}{}
+	if err := json.Unmarshal(dashboard.Content, &model); err != nil {
+		return "", "", errno.ERR_INVALID_GRAFANA_DASHBOARD.F("%s: %v", dashboard.Source, err)
+	}
+	model["id"] = nil
+
+	api := "/api/dashboards/db"
+	payload := map[string]interface{}{
+		"dashboard": model,
+		"overwrite": true,
+		"folderUid": GRAFANA_FOLDER_UID,
+	}
+	if inputs, ok := model["__inputs"].([]interface{}); ok && len(inputs) > 0 {
+		api = "/api/dashboards/import"
+		values := []map[string]interface{}{}
+		for _, item := range inputs {
+			input, ok := item.(map[string]interface{})
+			if !ok || input["type"] != "datasource" {
+				continue
+			}
+			pluginId, _ := input["pluginId"].(string)
+			uid, ok := datasources[pluginId]
+			if !ok {
+				return "", "", errno.ERR_INVALID_GRAFANA_DASHBOARD.
+					F("%s: datasource '%s' not found in grafana", dashboard.Source, pluginId)
+			}
+			values = append(values, map[string]interface{}{
+				"name":     input["name"],
+				"type":     "datasource",
+				"pluginId": pluginId,
+				"value":    uid,
+			})
+		}
+		payload["inputs"] = values
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", "", errno.ERR_INVALID_GRAFANA_DASHBOARD.E(err)
+	}
+	return api, string(data), nil
+}
+
+func (s *step2SyncDashboards) Execute(ctx *context.Context) error {
+	client, err := newGrafanaClient(ctx, s.cfg, s.execOptions)
+	if err != nil {
+		return err
+	}
+	defer client.close(ctx)
+
+	datasources, err := client.waitDatasources(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.ensureFolder(ctx); err != nil {
+		return err
+	}
+
+	payloadPath := filepath.Join(s.cfg.GetConfDir(), GRAFANA_DASHBOARD_PAYLOAD)
+	defer func() {
+		remove := &step.RemoveFile{Files: []string{payloadPath}, ExecOptions: s.execOptions}
+		remove.Execute(ctx)
+	}()
+	for _, dashboard := range s.dashboards {
+		api, payload, err := genDashboardPayload(dashboard, datasources)
+		if err != nil {
+			return err
+		}
+		install := &step.InstallFile{
+			Content:      &payload,
+			HostDestPath: payloadPath,
+			ExecOptions:  s.execOptions,
+		}
+		if err := install.Execute(ctx); err != nil {
+			return err
+		}
+		if _, err := client.request(ctx, "POST", api, "@"+payloadPath, true); err != nil {
+			return errno.ERR_GRAFANA_API_REQUEST_FAILED.
+				F("sync dashboard '%s' (%s) failed: %v", dashboard.Title, dashboard.Uid, err)
+		}
+	}
+	return nil
+}
+
+func (s *step2ExportDashboards) Execute(ctx *context.Context) error {
+	client, err := newGrafanaClient(ctx, s.cfg, s.execOptions)
+	if err != nil {
+		return err
+	}
+	defer client.close(ctx)
+
+	out, err := client.request(ctx, "GET",
+		fmt.Sprintf("/api/search?type=dash-db&folderUIDs=%s", GRAFANA_FOLDER_UID), "", true)
+	if err != nil {
+		return err
+	}
+	items := []map[string]interface{}{}
+	if err := json.Unmarshal([]byte(out), &items); err != nil {
+		return errno.ERR_GRAFANA_API_REQUEST_FAILED.E(err)
+	}
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return errno.ERR_WRITE_GRAFANA_DASHBOARD_FAILED.E(err)
+	}
+	exported := map[string]string{}
+	for _, item := range items {
+		uid, _ := item["uid"].(string)
+		if len(uid) == 0 {
+			continue
+		}
+		out, err := client.request(ctx, "GET", "/api/dashboards/uid/"+uid, "", true)
+		if err != nil {
+			return err
+		}
+		resp := struct {
+			Dashboard map[string]interface{} `json:"dashboard"`
+		}{}
+		if err := json.Unmarshal([]byte(out), &resp); err != nil || resp.Dashboard == nil {
+			return errno.ERR_GRAFANA_API_REQUEST_FAILED.F("get dashboard '%s' failed", uid)
+		}
+
+		// drop instance specific fields, so the file can be synced into any grafana
+		delete(resp.Dashboard, "id")
+		delete(resp.Dashboard, "version")
+		data, err := json.MarshalIndent(resp.Dashboard, "", "  ")
+		if err != nil {
+			return errno.ERR_WRITE_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		file := filepath.Join(s.dir, uid+".json")
+		if err := os.WriteFile(file, append(data, '\n'), 0644); err != nil {
+			return errno.ERR_WRITE_GRAFANA_DASHBOARD_FAILED.E(err)
+		}
+		exported[uid] = file
+	}
+
+	s.memStorage.Set(comm.KEY_EXPORTED_DASHBOARDS, exported)
+	return nil
+}
+
+// LoadMonitorDashboards returns dashboards which will be synced into grafana
+func LoadMonitorDashboards(cfg *configure.MonitorConfig) ([]*configure.Dashboard, error) {
+	return configure.LoadDashboards(scripts.DASHBOARDS, cfg.GetKind(),
+		cfg.GetDashboardLanguage(), cfg.GetDashboardDir())
+}
+
+func newGrafanaTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig, name string) (*task.Task, error) {
+	serviceId := dingocli.GetServiceId(cfg.GetId())
+	containerId, err := dingocli.GetContainerId(serviceId)
+	if IsSkip(cfg, []string{ROLE_MONITOR_CONF, ROLE_NODE_EXPORTER}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	hc, err := dingocli.GetHost(cfg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
+		cfg.GetHost(), cfg.GetRole(), tui.TrimContainerId(containerId))
+	t := task.NewTask(name, subname, hc.GetSSHConfig())
+	// add step to task
+	var out string
+	t.AddStep(&step.ListContainers{ // gurantee container exist
+		ShowAll:     true,
+		Format:      `"{{.ID}}"`,
+		Filter:      fmt.Sprintf("id=%s", containerId),
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: common.CheckContainerExist(cfg.GetHost(), cfg.GetRole(), containerId, &out),
+	})
+	return t, nil
+}
+
+func NewSyncGrafanaDashboardTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*task.Task, error) {
+	dashboards, err := LoadMonitorDashboards(cfg)
+	if err != nil {
+		return nil, err
+	}
+	t, err := newGrafanaTask(dingocli, cfg, "Sync Grafana Dashboard")
+	if err != nil || t == nil {
+		return t, err
+	}
+
+	// dashboards are pushed by grafana http api now, remove the provisioned one
+	// of the older version which can't be saved from UI.
+	t.AddStep(&step.RemoveFile{
+		Files:       []string{fmt.Sprintf("%s/dashboards/%s", cfg.GetProvisionDir(), GRAFANA_LEGACY_DASHBOARD)},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step2SyncDashboards{
+		cfg:         cfg,
+		dashboards:  dashboards,
+		execOptions: dingocli.ExecOptions(),
+	})
+	return t, nil
+}
+
+func NewExportGrafanaDashboardTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*task.Task, error) {
+	t, err := newGrafanaTask(dingocli, cfg, "Export Grafana Dashboard")
+	if err != nil || t == nil {
+		return t, err
+	}
+
+	dir := dingocli.MemStorage().Get(comm.KEY_DASHBOARD_EXPORT_DIR).(string)
+	t.AddStep(&step2ExportDashboards{
+		cfg:         cfg,
+		dir:         dir,
+		memStorage:  dingocli.MemStorage(),
+		execOptions: dingocli.ExecOptions(),
+	})
+	return t, nil
+}
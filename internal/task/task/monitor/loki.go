@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dingodb/dingocli/internal/configure"
+	"github.com/dingodb/dingocli/internal/errno"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	LOKI_CONTAINER_DATA_PATH     = "/loki"
+	LOKI_CONTAINER_CONF_PATH     = "/etc/loki"
+	PROMTAIL_CONTAINER_DATA_PATH = "/promtail"
+	PROMTAIL_CONTAINER_CONF_PATH = "/etc/promtail"
+	DEFAULT_LOKI_GRPC_PORT       = 9095
+	DEFAULT_LOKI_RETENTION       = "7d"
+	LOKI_DATASOURCE_UID          = "loki"
+)
+
+type (
+	lokiServer struct {
+		HTTPListenPort int `yaml:"http_listen_port"`
+		GRPCListenPort int `yaml:"grpc_listen_port"`
+	}
+
+	lokiSchema struct {
+		From        string            `yaml:"from"`
+		Store       string            `yaml:"store"`
+		ObjectStore string            `yaml:"object_store"`
+		Schema      string            `yaml:"schema"`
+		Index       map[string]string `yaml:"index"`
+	}
+
+	lokiConfig struct {
+		AuthEnabled bool                   `yaml:"auth_enabled"`
+		Server      lokiServer             `yaml:"server"`
+		Common      map[string]interface{} `yaml:"common"`
+		Schema      map[string]interface{} `yaml:"schema_config"`
+		Limits      map[string]interface{} `yaml:"limits_config"`
+		Compactor   map[string]interface{} `yaml:"compactor"`
+	}
+
+	promtailTarget struct {
+		Targets []string          `json:"targets" yaml:"targets"`
+		Labels  map[string]string `json:"labels" yaml:"labels"`
+	}
+
+	promtailScrape struct {
+		JobName       string           `yaml:"job_name"`
+		StaticConfigs []promtailTarget `yaml:"static_configs"`
+	}
+
+	promtailClient struct {
+		Url            string            `yaml:"url"`
+		ExternalLabels map[string]string `yaml:"external_labels"`
+	}
+
+	promtailConfig struct {
+		Server        lokiServer        `yaml:"server"`
+		Positions     map[string]string `yaml:"positions"`
+		Clients       []promtailClient  `yaml:"clients"`
+		ScrapeConfigs []promtailScrape  `yaml:"scrape_configs"`
+	}
+
+	grafanaDatasource struct {
+		Name   string `yaml:"name"`
+		Type   string `yaml:"type"`
+		Uid    string `yaml:"uid"`
+		Access string `yaml:"access"`
+		Url    string `yaml:"url"`
+	}
+
+	grafanaDatasources struct {
+		ApiVersion  int                 `yaml:"apiVersion"`
+		Datasources []grafanaDatasource `yaml:"datasources"`
+	}
+)
+
+func marshalYaml(v interface{}) (string, error) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return "", errno.ERR_PARSE_MONITOR_CONFIGURE_FAILED.E(err)
+	}
+	return string(data), nil
+}
+
+// genLokiConfig generates loki.yml for single binary loki which stores chunks in local filesystem
+func genLokiConfig(cfg *configure.MonitorConfig) (string, error) {
+	grpcPort := cfg.GetGrpcListenPort()
+	if grpcPort <= 0 {
+		grpcPort = DEFAULT_LOKI_GRPC_PORT
+	}
+	retention := cfg.GetPrometheusRetentionTime()
+	if len(retention) == 0 {
+		retention = DEFAULT_LOKI_RETENTION
+	}
+
+	config := lokiConfig{
+		AuthEnabled: false,
+		Server: lokiServer{
+			HTTPListenPort: cfg.GetListenPort(),
+			GRPCListenPort: grpcPort,
+		},
+		Common: map[string]interface{}{
+			"path_prefix":        LOKI_CONTAINER_DATA_PATH,
+			"replication_factor": 1,
+			"storage": map[string]interface{}{
+				"filesystem": map[string]string{
+					"chunks_directory": LOKI_CONTAINER_DATA_PATH + "/chunks",
+					"rules_directory":  LOKI_CONTAINER_DATA_PATH + "/rules",
+				},
+			},
+			"ring": map[string]interface{}{
+				"instance_addr": "127.0.0.1",
+				"kvstore":       map[string]string{"store": "inmemory"},
+			},
+		},
+		Schema: map[string]interface{}{
+			"configs": []lokiSchema{{
+				From:        "2024-01-01",
+				Store:       "tsdb",
+				ObjectStore: "filesystem",
+				Schema:      "v13",
+				Index:       map[string]string{"prefix": "index_", "period": "24h"},
+			}},
+		},
+		Limits: map[string]interface{}{
+			"retention_period": retention,
+		},
+		Compactor: map[string]interface{}{
+			"working_directory":    LOKI_CONTAINER_DATA_PATH + "/compactor",
+			"retention_enabled":    true,
+			"delete_request_store": "filesystem",
+		},
+	}
+	return marshalYaml(config)
+}
+
+// genPromtailConfig generates promtail.yml, every service on the host is a scrape job
+func genPromtailConfig(cfg *configure.MonitorConfig, clusterName string) (string, error) {
+	targets := []promtailTarget{}
+	if err := json.Unmarshal([]byte(cfg.GetPromtailTarget()), &targets); err != nil {
+		return "", errno.ERR_PARSE_PROMTAIL_TARGET_FAILED.E(err)
+	}
+
+	config := promtailConfig{
+		Server: lokiServer{
+			HTTPListenPort: cfg.GetListenPort(),
+			GRPCListenPort: 0, // random port, avoid conflicting with loki on the same host
+		},
+		Positions: map[string]string{
+			"filename": PROMTAIL_CONTAINER_DATA_PATH + "/positions.yaml",
+		},
+		Clients: []promtailClient{{
+			Url:            fmt.Sprintf("http://%s/loki/api/v1/push", cfg.GetLokiAddr()),
+			ExternalLabels: map[string]string{"cluster": clusterName},
+		}},
+		ScrapeConfigs: []promtailScrape{},
+	}
+	for _, target := range targets {
+		config.ScrapeConfigs = append(config.ScrapeConfigs, promtailScrape{
+			JobName:       target.Labels["instance"],
+			StaticConfigs: []promtailTarget{target},
+		})
+	}
+	return marshalYaml(config)
+}
+
+// genLokiDatasource generates the grafana datasource provisioning file for loki
+func genLokiDatasource(cfg *configure.MonitorConfig) (string, error) {
+	return marshalYaml(grafanaDatasources{
+		ApiVersion: 1,
+		Datasources: []grafanaDatasource{{
+			Name:   "Loki",
+			Type:   "loki",
+			Uid:    LOKI_DATASOURCE_UID,
+			Access: "proxy",
+			Url:    fmt.Sprintf("http://%s", cfg.GetLokiAddr()),
+		}},
+	})
+}
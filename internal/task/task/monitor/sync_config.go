@@ -17,14 +17,11 @@
 package monitor
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/configure"
-	"github.com/dingodb/dingocli/internal/task/context"
 	"github.com/dingodb/dingocli/internal/task/scripts"
 	"github.com/dingodb/dingocli/internal/task/step"
 	"github.com/dingodb/dingocli/internal/task/task"
@@ -34,55 +31,19 @@ import (
 )
 
 const (
-	MONITOR_CONF_PATH              = "monitor"
-	PROMETHEUS_CONTAINER_CONF_PATH = "/etc/prometheus"
-	GRAFANA_CONTAINER_PATH         = "/etc/grafana/grafana.ini"
-	DASHBOARD_CONTAINER_PATH       = "/etc/grafana/provisioning/dashboards"
-	GRAFANA_DATA_SOURCE_PATH       = "/etc/grafana/provisioning/datasources/all.yml"
-	DINGO_TOOL_SRC_PATH            = "/dingofs/conf/dingo.yaml"
-	DINGO_TOOL_DEST_PATH           = "/root/.dingo/dingo.yaml"
-	ORIGIN_MONITOR_PATH            = "/dingofs/monitor"
+	MONITOR_CONF_PATH                = "monitor"
+	PROMETHEUS_CONTAINER_CONF_PATH   = "/etc/prometheus"
+	PROMETHEUS_RULES_DIR             = "rules"
+	ALERTMANAGER_CONTAINER_CONF_PATH = "/etc/alertmanager"
+	GRAFANA_CONTAINER_PATH           = "/etc/grafana/grafana.ini"
+	DASHBOARD_CONTAINER_PATH         = "/etc/grafana/provisioning/dashboards"
+	GRAFANA_DATA_SOURCE_PATH         = "/etc/grafana/provisioning/datasources/all.yml"
+	GRAFANA_LOKI_DATA_SOURCE_FILE    = "loki.yml"
+	DINGO_TOOL_SRC_PATH              = "/dingofs/conf/dingo.yaml"
+	DINGO_TOOL_DEST_PATH             = "/root/.dingo/dingo.yaml"
+	ORIGIN_MONITOR_PATH              = "/dingofs/monitor"
 )
 
-func syncPrometheusUid(cfg *configure.MonitorConfig, dingocli cli.DingoCli) step.LambdaType {
-	return func(ctx *context.Context) error {
-		var prometheusInfo string
-		// fetch prometheus info retry 10 times
-		for i := 0; i < 10; i++ {
-			curlStep := &step.Curl{
-				Url:         fmt.Sprintf("-u admin:admin http://localhost:%d/api/datasources", cfg.GetListenPort()),
-				Insecure:    true,
-				Out:         &prometheusInfo,
-				Silent:      true,
-				ExecOptions: dingocli.ExecOptions(),
-			}
-			curlStep.Execute(ctx)
-			if len(prometheusInfo) > 0 {
-				// try parse prometheus info
-				var arr []map[string]interface{}
-				if err := json.Unmarshal([]byte(prometheusInfo), &arr); err == nil && len(arr) > 0 {
-					if v, ok := arr[0]["uid"].(string); ok {
-						// *prometheusUid = v
-						sedStep := &step.Command{
-							Command:     combineSedCMD(cfg, v),
-							ExecOptions: dingocli.ExecOptions(),
-						}
-						sedStep.Execute(ctx)
-						return nil
-					}
-					return nil
-				}
-			}
-			time.Sleep(3 * time.Second)
-		}
-		return fmt.Errorf("failed to fetch prometheus info")
-	}
-}
-
-func combineSedCMD(cfg *configure.MonitorConfig, prometheusUid string) string {
-	return fmt.Sprintf(`sed -i 's/${PROMETHEUS_UID}/%s/g' %s/dashboards/server_metric_zh.json`, prometheusUid, cfg.GetProvisionDir())
-}
-
 func MutateTool(vars *variable.Variables, delimiter string) step.Mutate {
 	return func(in, key, value string) (out string, err error) {
 		if len(key) == 0 {
@@ -172,6 +133,40 @@ func NewSyncConfigTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*t
 			ExecOptions: dingocli.ExecOptions(),
 		})
 
+		// install default alert rules and point prometheus to alertmanager
+		rulesDir := fmt.Sprintf("%s/%s", cfg.GetConfDir(), PROMETHEUS_RULES_DIR)
+		t.AddStep(&step.CreateDirectory{
+			Paths:       []string{rulesDir},
+			ExecOptions: dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.InstallFile{
+			HostDestPath: fmt.Sprintf("%s/dingofs.yml", rulesDir),
+			Content:      &scripts.DINGOFS_ALERT_RULES,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.InstallFile{
+			HostDestPath: fmt.Sprintf("%s/sync_alerting.sh", cfg.GetConfDir()),
+			Content:      &scripts.SYNC_ALERTING,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+		t.AddStep(&step.Command{
+			Command: fmt.Sprintf("bash %s/sync_alerting.sh %s/prometheus.yml '%s/%s/*.yml' %s",
+				cfg.GetConfDir(), cfg.GetConfDir(), PROMETHEUS_CONTAINER_CONF_PATH, PROMETHEUS_RULES_DIR, cfg.GetAlertmanagerAddr()),
+			Out:         &out,
+			ExecOptions: dingocli.ExecOptions(),
+		})
+
+	case ROLE_ALERTMANAGER:
+		config, err := genAlertmanagerConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		t.AddStep(&step.InstallFile{
+			HostDestPath: fmt.Sprintf("%s/alertmanager.yml", cfg.GetConfDir()),
+			Content:      &config,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+
 	case ROLE_GRAFANA:
 
 		// replace grafana/provisioning/datasources/all.yml port info
@@ -182,6 +177,47 @@ func NewSyncConfigTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*t
 			ExecOptions: dingocli.ExecOptions(),
 		})
 
+		// provision loki datasource next to prometheus
+		lokiDatasourcePath := fmt.Sprintf("%s/datasources/%s", cfg.GetProvisionDir(), GRAFANA_LOKI_DATA_SOURCE_FILE)
+		if len(cfg.GetLokiAddr()) > 0 {
+			datasource, err := genLokiDatasource(cfg)
+			if err != nil {
+				return nil, err
+			}
+			t.AddStep(&step.InstallFile{
+				HostDestPath: lokiDatasourcePath,
+				Content:      &datasource,
+				ExecOptions:  dingocli.ExecOptions(),
+			})
+		} else {
+			t.AddStep(&step.RemoveFile{
+				Files:       []string{lokiDatasourcePath},
+				ExecOptions: dingocli.ExecOptions(),
+			})
+		}
+
+	case ROLE_LOKI:
+		config, err := genLokiConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		t.AddStep(&step.InstallFile{
+			HostDestPath: fmt.Sprintf("%s/loki.yml", cfg.GetConfDir()),
+			Content:      &config,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+
+	case ROLE_PROMTAIL:
+		config, err := genPromtailConfig(cfg, dingocli.ClusterName())
+		if err != nil {
+			return nil, err
+		}
+		t.AddStep(&step.InstallFile{
+			HostDestPath: fmt.Sprintf("%s/promtail.yml", cfg.GetConfDir()),
+			Content:      &config,
+			ExecOptions:  dingocli.ExecOptions(),
+		})
+
 	case ROLE_MONITOR_SYNC:
 
 		confID := cfg.GetServiceConfig()[configure.KEY_ORIGIN_CONFIG_ID].(string)
@@ -224,50 +260,3 @@ func NewSyncConfigTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*t
 	}
 	return t, nil
 }
-
-func NewSyncGrafanaDashboardTask(dingocli *cli.DingoCli, cfg *configure.MonitorConfig) (*task.Task, error) {
-	serviceId := dingocli.GetServiceId(cfg.GetId())
-	containerId, err := dingocli.GetContainerId(serviceId)
-	if IsSkip(cfg, []string{ROLE_MONITOR_CONF, ROLE_NODE_EXPORTER}) {
-		return nil, nil
-	} else if err != nil {
-		return nil, err
-	}
-
-	_, host := cfg.GetRole(), cfg.GetHost()
-	hc, err := dingocli.GetHost(host)
-	if err != nil {
-		return nil, err
-	}
-
-	// new task
-	subname := fmt.Sprintf("host=%s role=%s containerId=%s",
-		cfg.GetHost(), cfg.GetRole(), tui.TrimContainerId(containerId))
-	t := task.NewTask("Sync Grafana Dashboard", subname, hc.GetSSHConfig())
-	// add step to task
-	var out string
-	t.AddStep(&step.ListContainers{ // gurantee container exist
-		ShowAll:     true,
-		Format:      `"{{.ID}}"`,
-		Filter:      fmt.Sprintf("id=%s", containerId),
-		Out:         &out,
-		ExecOptions: dingocli.ExecOptions(),
-	})
-	t.AddStep(&step.Lambda{
-		Lambda: common.CheckContainerExist(cfg.GetHost(), cfg.GetRole(), containerId, &out),
-	})
-
-	t.AddStep(&step.InstallFile{ // install server_metric_zh.json
-		HostDestPath: fmt.Sprintf("%s/dashboards/%s", cfg.GetProvisionDir(), "server_metric_zh.json"),
-		Content:      &scripts.GRAFANA_SERVER_METRIC,
-		ExecOptions:  dingocli.ExecOptions(),
-	})
-
-	// wait for grafana service started
-	t.AddStep(&step.Lambda{
-		//Lambda: wait(30),
-		Lambda: syncPrometheusUid(cfg, *dingocli),
-	})
-
-	return t, nil
-}
@@ -17,6 +17,7 @@
 package task
 
 import (
+	stdctx "context"
 	"errors"
 
 	"github.com/dingodb/dingocli/internal/errno"
@@ -26,8 +27,9 @@ import (
 )
 
 var (
-	ERR_SKIP_TASK = errors.New("skip task")
-	ERR_TASK_DONE = errors.New("task done")
+	ERR_SKIP_TASK     = errors.New("skip task")
+	ERR_TASK_DONE     = errors.New("task done")
+	ERR_TASK_CANCELED = errors.New("task canceled")
 )
 
 type (
@@ -74,6 +76,14 @@ func (t *Task) Subname() string {
 	return t.subname
 }
 
+// Host returns the host which task executed on, empty if it's executed locally
+func (t *Task) Host() string {
+	if t.sshConfig == nil {
+		return ""
+	}
+	return t.sshConfig.Host
+}
+
 func (t *Task) SetTid(tid string) {
 	t.tid = tid
 }
@@ -94,7 +104,13 @@ func (t *Task) AddPostStep(step Step) {
 	t.postSteps = append(t.postSteps, step)
 }
 
+// post steps are used for cleanup, so we run them even if task canceled
 func (t *Task) executePost(ctx *context.Context) {
+	if len(t.postSteps) == 0 {
+		return
+	}
+
+	ctx = ctx.Detach()
 	for _, step := range t.postSteps {
 		err := step.Execute(ctx)
 		if err != nil {
@@ -103,17 +119,52 @@ func (t *Task) executePost(ctx *context.Context) {
 	}
 }
 
-func (t *Task) Execute() error {
+func connectError(err error) error {
+	switch {
+	case errors.Is(err, module.ErrHostKeyMismatch):
+		return errno.ERR_SSH_HOST_KEY_MISMATCH.E(err)
+	case errors.Is(err, module.ErrHostKeyUnknown):
+		return errno.ERR_SSH_HOST_KEY_UNKNOWN.E(err)
+	case errors.Is(err, module.ErrHostKeyRejected):
+		return errno.ERR_SSH_HOST_KEY_REJECTED.E(err)
+	}
+	return errno.ERR_SSH_CONNECT_FAILED.E(err)
+}
+
+// Prepare resolves the SSH secrets (e.g. prompt user for password) ahead of execution
+func (t *Task) Prepare() error {
+	if t.sshConfig == nil {
+		return nil
+	}
+	return t.sshConfig.Prepare()
+}
+
+// return non-nil error if the task is canceled by user or reached the deadline
+func interrupted(c stdctx.Context) error {
+	switch c.Err() {
+	case stdctx.Canceled:
+		return ERR_TASK_CANCELED
+	case stdctx.DeadlineExceeded:
+		return errno.ERR_EXECUTE_TASK_TIMED_OUT
+	}
+	return nil
+}
+
+func (t *Task) Execute(c stdctx.Context) error {
+	if c.Err() != nil { // interrupted before scheduled
+		return ERR_TASK_CANCELED
+	}
+
 	var sshClient *module.SSHClient
 	if t.sshConfig != nil {
-		client, err := module.NewSSHClient(*t.sshConfig)
+		client, err := module.GlobalSSHPool().Get(*t.sshConfig)
 		if err != nil {
-			return errno.ERR_SSH_CONNECT_FAILED.E(err)
+			return connectError(err)
 		}
 		sshClient = client
 	}
 
-	ctx, err := context.NewContext(sshClient)
+	ctx, err := context.NewContext(c, sshClient)
 	if err != nil {
 		return err
 	}
@@ -121,8 +172,14 @@ func (t *Task) Execute() error {
 	defer t.executePost(ctx)
 
 	for _, step := range t.steps {
+		if err := interrupted(c); err != nil {
+			return err
+		}
+
 		err := step.Execute(ctx)
-		if err == ERR_TASK_DONE || err == ERR_SKIP_TASK {
+		if c.Err() == stdctx.Canceled {
+			return ERR_TASK_CANCELED
+		} else if err == ERR_TASK_DONE || err == ERR_SKIP_TASK {
 			break
 		} else if err != nil {
 			return err
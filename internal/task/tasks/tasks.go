@@ -17,6 +17,7 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -232,7 +233,7 @@ func (ts *Tasks) Execute(option ExecOptions) error {
 			if bar != nil {
 				id = bar.ID()
 			}
-			err := t.Execute()
+			err := t.Execute(context.Background())
 			ts.monitor.set(id, err)
 		}(t)
 	}
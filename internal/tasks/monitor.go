@@ -25,6 +25,7 @@ import (
 const (
 	STATUS_OK = iota
 	STATUS_SKIP
+	STATUS_CANCEL
 	STATUS_ERROR
 )
 
@@ -46,37 +47,46 @@ func (m *monitor) error() error {
 	return m.err
 }
 
-// return number of {success, skip, error}
-func (m *monitor) sum(bid int) (int, int, int) {
-	m.mutex.Lock()
-	defer m.mutex.Unlock()
-	nsucc, nskip, nerr := 0, 0, 0
-	for _, err := range m.result[bid] {
+func count(errs []error) (nsucc, nskip, ncancel, nerr int) {
+	for _, err := range errs {
 		if err == nil {
 			nsucc++
 		} else if err == task.ERR_SKIP_TASK {
 			nskip++
+		} else if err == task.ERR_TASK_CANCELED {
+			ncancel++
 		} else {
 			nerr++
 		}
 	}
-	return nsucc, nskip, nerr
+	return
+}
+
+// return number of {success, skip, cancel, error}
+func (m *monitor) sum(bid int) (int, int, int, int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return count(m.result[bid])
 }
 
 func (m *monitor) set(bid int, err error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	m.result[bid] = append(m.result[bid], err)
-	if err != nil && err != task.ERR_SKIP_TASK {
+	if err == nil || err == task.ERR_SKIP_TASK {
+		return
+	} else if err != task.ERR_TASK_CANCELED || m.err == nil { // real error first
 		m.err = err
 	}
 }
 
 func (m *monitor) get(bid int) int {
-	nsucc, nskip, nerr := m.sum(bid)
-	total := nsucc + nskip + nerr
+	nsucc, nskip, ncancel, nerr := m.sum(bid)
+	total := nsucc + nskip + ncancel + nerr
 	if nerr != 0 {
 		return STATUS_ERROR
+	} else if ncancel != 0 {
+		return STATUS_CANCEL
 	} else if nskip == total {
 		return STATUS_SKIP
 	}
@@ -17,10 +17,13 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/task/task"
 	tui "github.com/dingodb/dingocli/internal/tui/common"
 	"github.com/fatih/color"
@@ -34,10 +37,31 @@ type (
 		SilentMainBar bool
 		SilentSubBar  bool
 		SkipError     bool
+		Timeout       time.Duration // timeout for all tasks, 0 means no limit
+	}
+
+	// Summary is the statistics of tasks result
+	Summary struct {
+		Name      string
+		Total     int
+		Succeeded int
+		Skipped   int
+		Canceled  int
+		Failed    int
+	}
+
+	// Result is the result of one task, e.g. the check on one host
+	Result struct {
+		Name    string
+		Subname string
+		Host    string
+		Err     error
 	}
 
 	Tasks struct {
 		tasks    []*task.Task
+		results  []error
+		outcomes []Result
 		monitor  *monitor
 		wg       sync.WaitGroup
 		progress *mpb.Progress
@@ -51,6 +75,8 @@ func NewTasks() *Tasks {
 	wg := sync.WaitGroup{}
 	return &Tasks{
 		tasks:    []*task.Task{},
+		results:  []error{},
+		outcomes: []Result{},
 		monitor:  newMonitor(),
 		wg:       wg,
 		progress: mpb.New(mpb.WithWaitGroup(&wg)),
@@ -63,6 +89,23 @@ func (ts *Tasks) AddTask(t ...*task.Task) {
 	ts.tasks = append(ts.tasks, t...)
 }
 
+func (ts *Tasks) Summary() Summary {
+	ts.Lock()
+	defer ts.Unlock()
+	summary := Summary{Total: len(ts.tasks)}
+	if len(ts.tasks) > 0 {
+		summary.Name = ts.tasks[0].Name()
+	}
+	summary.Succeeded, summary.Skipped, summary.Canceled, summary.Failed = count(ts.results)
+	return summary
+}
+
+func (ts *Tasks) Results() []Result {
+	ts.Lock()
+	defer ts.Unlock()
+	return append([]Result{}, ts.outcomes...)
+}
+
 func (ts *Tasks) CountPtid(ptid string) int64 {
 	var sum int64 = 0
 	for _, t := range ts.tasks {
@@ -107,6 +150,8 @@ func (ts *Tasks) displayStatus() func(static decor.Statistics) string {
 				return color.GreenString("[OK]")
 			} else if status == STATUS_SKIP {
 				return color.YellowString("[SKIP]")
+			} else if status == STATUS_CANCEL {
+				return color.YellowString("[CANCEL]")
 			} else {
 				return color.RedString("[ERROR]")
 			}
@@ -118,7 +163,7 @@ func (ts *Tasks) displayStatus() func(static decor.Statistics) string {
 func (ts *Tasks) displayInstances(t *task.Task) func(static decor.Statistics) string {
 	total := ts.CountPtid(t.Ptid())
 	return func(static decor.Statistics) string {
-		nsucc, nskip, _ := ts.monitor.sum(static.ID)
+		nsucc, nskip, _, _ := ts.monitor.sum(static.ID)
 		return fmt.Sprintf("[%d/%d]", nsucc+nskip, total)
 	}
 }
@@ -169,18 +214,22 @@ func (ts *Tasks) setMainBarStatus() {
 	defer ts.Unlock()
 	monitor := ts.monitor
 	id := ts.mainBar.ID()
-	nsucc := 0
+	nsucc, ncancel := 0, 0
 	for _, bar := range ts.subBar {
 		status := monitor.get(bar.ID())
 		if status == STATUS_ERROR {
 			monitor.set(id, monitor.error())
 			return
+		} else if status == STATUS_CANCEL {
+			ncancel++
 		} else if status == STATUS_OK {
 			nsucc++
 		}
 	}
 
-	if nsucc == 0 { // all task skip
+	if ncancel > 0 {
+		monitor.set(id, task.ERR_TASK_CANCELED)
+	} else if nsucc == 0 { // all task skip
 		monitor.set(id, task.ERR_SKIP_TASK)
 	} else {
 		monitor.set(id, nil)
@@ -196,13 +245,25 @@ func (ts *Tasks) setMainBarStatus() {
  *   + host=10.0.0.2  image=dingodatabase/dingofs [10/10] [OK]
  *   + host=10.0.0.3  image=dingodatabase/dingofs [1/10] [OK]
  */
-func (ts *Tasks) Execute(options ExecOptions) error {
+func (ts *Tasks) Execute(ctx context.Context, options ExecOptions) error {
 	if len(ts.tasks) == 0 {
 		return nil
 	}
 
+	// prompt for SSH secrets before the progress bars are displayed
+	for _, t := range ts.tasks {
+		if err := t.Prepare(); err != nil {
+			return err
+		}
+	}
+
 	ts.prettySubname()
 	options = ts.initOptions(options)
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
 	workers := make(chan struct{}, options.Concurrency)
 	if !options.SilentMainBar {
 		ts.addMainBar()
@@ -210,17 +271,22 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 
 	// execute task by concurrency
 	for _, t := range ts.tasks {
-		// FIXME: if we break here, the process bar maybe wait forever
-		//        for we didn't execute all the tasks because of the false early appearance
-		// if ts.monitor.error() != nil && options.SkipError == false {
-		// 	break
-		// }
 		ts.wg.Add(1)
-		workers <- struct{}{}
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+		}
 		if !options.SilentSubBar {
 			ts.addSubBar(t)
 		}
 
+		// stop scheduling: the remaining tasks are marked as canceled
+		// instead of breaking the loop, so that the process bar can finish
+		if ctx.Err() != nil {
+			ts.cancel(t)
+			continue
+		}
+
 		// worker
 		go func(t *task.Task) {
 			bar := ts.getSubBar(t)
@@ -233,12 +299,8 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 			}()
 
 			// execute task
-			id := 0
-			if bar != nil {
-				id = bar.ID()
-			}
-			err := t.Execute()
-			ts.monitor.set(id, err)
+			err := t.Execute(ctx)
+			ts.record(t, bar, err)
 		}(t)
 	}
 
@@ -248,5 +310,37 @@ func (ts *Tasks) Execute(options ExecOptions) error {
 		ts.setMainBarStatus()
 	}
 	ts.progress.Wait()
+	if ctx.Err() == context.DeadlineExceeded {
+		return errno.ERR_EXECUTE_TASK_TIMED_OUT.
+			F("%s: timeout=%s", ts.tasks[0].Name(), options.Timeout)
+	}
 	return ts.monitor.error()
 }
+
+func (ts *Tasks) record(t *task.Task, bar *mpb.Bar, err error) {
+	id := 0
+	if bar != nil {
+		id = bar.ID()
+	}
+	ts.monitor.set(id, err)
+
+	ts.Lock()
+	defer ts.Unlock()
+	ts.results = append(ts.results, err)
+	ts.outcomes = append(ts.outcomes, Result{
+		Name:    t.Name(),
+		Subname: t.Subname(),
+		Host:    t.Host(),
+		Err:     err,
+	})
+}
+
+// mark the task which not scheduled as canceled
+func (ts *Tasks) cancel(t *task.Task) {
+	defer ts.wg.Done()
+	bar := ts.getSubBar(t)
+	ts.record(t, bar, task.ERR_TASK_CANCELED)
+	if bar != nil {
+		bar.IncrBy(1)
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"bytes"
 	"fmt"
 	"os/exec"
+	"path"
 	"strings"
 	"text/template"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
 )
 
 const (
@@ -37,6 +39,17 @@ const (
 	TEMPLATE_COMMAND_EXEC_CONTAINER_NOATTACH = `{{.sudo}} {{.engine}} exec -t {{.container_id}} /bin/bash -c "{{.command}}"`
 )
 
+// convert host key policy to the value of OpenSSH option StrictHostKeyChecking
+func strictHostKeyChecking(policy string) string {
+	switch policy {
+	case module.HOST_KEY_POLICY_STRICT:
+		return "yes"
+	case module.HOST_KEY_POLICY_ASK:
+		return "ask"
+	}
+	return "accept-new"
+}
+
 func prepareOptions(dingocli *cli.DingoCli, host string, become bool, extra map[string]interface{}) (map[string]interface{}, error) {
 	options := map[string]interface{}{}
 	hc, err := dingocli.GetHost(host)
@@ -50,11 +63,25 @@ func prepareOptions(dingocli *cli.DingoCli, host string, become bool, extra map[
 	options["port"] = config.Port
 
 	opts := []string{
-		"-o StrictHostKeyChecking=no",
+		fmt.Sprintf("-o StrictHostKeyChecking=%s", strictHostKeyChecking(config.HostKeyPolicy)),
 		//"-o UserKnownHostsFile=/dev/null",
 	}
-	if !config.ForwardAgent {
+	if len(config.ProxyJump) > 0 {
+		jumpOpts, err := prepareProxyJump(dingocli, config.ProxyJump)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, jumpOpts...)
+	}
+	if config.ForwardAgent {
+		// use keys in agent
+	} else if len(config.PrivateKeyPath) > 0 {
 		opts = append(opts, fmt.Sprintf("-i %s", config.PrivateKeyPath))
+		if len(config.CertificatePath) > 0 {
+			opts = append(opts, fmt.Sprintf("-o CertificateFile=%s", config.CertificatePath))
+		}
+	} else if config.Password != nil {
+		opts = append(opts, "-o PreferredAuthentications=password,keyboard-interactive")
 	}
 	if len(config.BecomeUser) > 0 && become {
 		options["become"] = fmt.Sprintf("%s %s %s",
@@ -69,6 +96,53 @@ func prepareOptions(dingocli *cli.DingoCli, host string, become bool, extra map[
 	return options, nil
 }
 
+/*
+ * each jump host may use its own private key which can't be specified
+ * by "-J", so we generate a ssh config file for jump hosts, e.g.
+ *
+ *   Host dingo-jump-0
+ *     HostName 10.0.0.1
+ *     ...
+ *   Host dingo-jump-1
+ *     HostName 10.0.0.2
+ *     ...
+ *     ProxyJump dingo-jump-0
+ *
+ * and connect the target host with "-F <file> -o ProxyJump=dingo-jump-1".
+ */
+func prepareProxyJump(dingocli *cli.DingoCli, jumps []module.SSHConfig) ([]string, error) {
+	lines := []string{}
+	for i, jump := range jumps {
+		lines = append(lines,
+			fmt.Sprintf("Host dingo-jump-%d", i),
+			fmt.Sprintf("    HostName %s", jump.Host),
+			fmt.Sprintf("    Port %d", jump.Port),
+			fmt.Sprintf("    User %s", jump.User),
+			fmt.Sprintf("    StrictHostKeyChecking %s", strictHostKeyChecking(jump.HostKeyPolicy)))
+		if !jump.ForwardAgent {
+			lines = append(lines,
+				fmt.Sprintf("    IdentityFile %s", jump.PrivateKeyPath),
+				"    IdentitiesOnly yes")
+			if len(jump.CertificatePath) > 0 {
+				lines = append(lines, fmt.Sprintf("    CertificateFile %s", jump.CertificatePath))
+			}
+		}
+		if i > 0 {
+			lines = append(lines, fmt.Sprintf("    ProxyJump dingo-jump-%d", i-1))
+		}
+	}
+
+	content := strings.Join(lines, "\n") + "\n"
+	filename := path.Join(dingocli.TempDir(), fmt.Sprintf("ssh_config_%s", utils.MD5Sum(content)))
+	if err := utils.WriteFile(filename, content, 0600); err != nil {
+		return nil, errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	return []string{
+		fmt.Sprintf("-F %s", filename),
+		fmt.Sprintf("-o ProxyJump=dingo-jump-%d", len(jumps)-1),
+	}, nil
+}
+
 func newCommand(dingocli *cli.DingoCli, text string, options map[string]interface{}) (*exec.Cmd, error) {
 	tmpl := template.Must(template.New(utils.MD5Sum(text)).Parse(text))
 	buffer := bytes.NewBufferString("")
@@ -185,7 +259,33 @@ func ExecCmdInRemoteContainer(dingocli *cli.DingoCli, host, containerId, cmd str
 	return ssh(dingocli, options)
 }
 
+// checkNonInteractiveAuth rejects the auth which requires a secret (password or
+// passphrase of private key), the OpenSSH command runs without stdin can't get
+// the secret (env:/file:) resolved by dingo, it will hang or fail.
+func checkNonInteractiveAuth(dingocli *cli.DingoCli, host string) error {
+	hc, err := dingocli.GetHost(host)
+	if err != nil {
+		return err
+	}
+
+	config := hc.GetSSHConfig()
+	for _, c := range append([]module.SSHConfig{*config}, config.ProxyJump...) {
+		if c.ForwardAgent {
+			continue
+		} else if len(c.PrivateKeyPath) > 0 && c.Passphrase == nil {
+			continue
+		} else if len(c.PrivateKeyPath) == 0 && c.Password == nil {
+			continue
+		}
+		return errno.ERR_SSH_AUTH_NOT_SUPPORTED.F("%s@%s:%d", c.User, c.Host, c.Port)
+	}
+	return nil
+}
+
 func Scp(dingocli *cli.DingoCli, host, source, target string) error {
+	if err := checkNonInteractiveAuth(dingocli, host); err != nil {
+		return err
+	}
 	options, err := prepareOptions(dingocli, host, false,
 		map[string]interface{}{
 			"source": source,
@@ -198,6 +298,9 @@ func Scp(dingocli *cli.DingoCli, host, source, target string) error {
 }
 
 func ExecuteRemoteCommand(dingocli *cli.DingoCli, host, command string) (string, error) {
+	if err := checkNonInteractiveAuth(dingocli, host); err != nil {
+		return "", err
+	}
 	options, err := prepareOptions(dingocli, host, true,
 		map[string]interface{}{"command": command})
 	if err != nil {
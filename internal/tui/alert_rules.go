@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"sort"
+
+	"github.com/dingodb/dingocli/internal/configure"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+func FormatAlertRuleIssues(issues map[string][]configure.AlertRuleIssue) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"File",
+		"Group",
+		"Rule",
+		"Issue",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	files := []string{}
+	for file := range issues {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		for _, issue := range issues[file] {
+			lines = append(lines, []interface{}{
+				file,
+				utils.Choose(len(issue.Group) > 0, issue.Group, "-"),
+				utils.Choose(len(issue.Rule) > 0, issue.Rule, "-"),
+				issue.Issue,
+			})
+		}
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
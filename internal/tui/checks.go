@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"strconv"
+
+	"github.com/dingodb/dingocli/internal/task/task/checker"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+)
+
+func checkStatusDecorate(status string) string {
+	switch status {
+	case checker.CHECK_STATUS_PASS:
+		return color.GreenString(status)
+	case checker.CHECK_STATUS_WARN:
+		return color.YellowString(status)
+	}
+	return color.RedString(status)
+}
+
+func FormatCheckResults(results []checker.CheckResult) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Role",
+		"Check",
+		"Severity",
+		"Value",
+		"Status",
+		"Error Code",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, r := range results {
+		lines = append(lines, []interface{}{
+			r.Host,
+			utils.Choose(len(r.Role) > 0, r.Role, "-"),
+			r.Check,
+			r.Severity,
+			utils.Choose(len(r.Value) > 0, r.Value, "-"),
+			tuicommon.DecorateMessage{Message: r.Status, Decorate: checkStatusDecorate},
+			utils.Choose(r.Code > 0, strconv.Itoa(r.Code), "-"),
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -41,6 +41,12 @@ const (
   - Clean items : [{{.items}}]
 `
 
+	PROMPT_PREPARE_DISKS = `{{.warning}}
+{{- range .disks}}
+  - {{.}}
+{{- end}}
+`
+
 	PROMPT_TOPOLOGY_CHANGE_NOTICE = `
 NOTICE: If you have modified the configuration of some services while 
 {{.operation}} and you want make these configurations effect, you 
@@ -177,6 +183,16 @@ func PromptCleanService(role, host string, items []string) string {
 	return prompt.Build()
 }
 
+func PromptPrepareDisks(disks []string, force bool) string {
+	prompt := NewPrompt(color.YellowString(PROMPT_PREPARE_DISKS) + DEFAULT_CONFIRM_PROMPT)
+	prompt.data["warning"] = "WARNING: disks below will be formatted and mounted"
+	if force {
+		prompt.data["warning"] = "WARNING: disks below will be formatted by force, all data on them will be lost"
+	}
+	prompt.data["disks"] = disks
+	return prompt.Build()
+}
+
 func prettyClue(clue string) string {
 	items := strings.Split(clue, "\n")
 	for {
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/dingodb/dingocli/internal/utils"
+	"golang.org/x/term"
 )
 
 type DecorateMessage struct {
@@ -158,3 +159,19 @@ func ConfirmYes(format string, a ...interface{}) bool {
 		return false
 	}
 }
+
+// PromptSecret reads a line from terminal without echo
+func PromptSecret(format string, a ...interface{}) (string, error) {
+	fmt.Print(fmt.Sprintf(format, a...) + " ")
+	defer fmt.Println()
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return prompt(""), nil
+	}
+	secret, err := term.ReadPassword(fd)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
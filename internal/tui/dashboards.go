@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"github.com/dingodb/dingocli/internal/configure"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+)
+
+func FormatDashboards(dashboards []*configure.Dashboard) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Uid",
+		"Title",
+		"Source",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, dashboard := range dashboards {
+		lines = append(lines, []interface{}{
+			dashboard.Uid,
+			dashboard.Title,
+			dashboard.Source,
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package tui
+
+import (
+	"strings"
+
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+)
+
+func diskStatusDecorate(status string) string {
+	switch status {
+	case task.DISK_STATUS_READY:
+		return color.GreenString(status)
+	case task.DISK_STATUS_NOT_FORMATTED, task.DISK_STATUS_NOT_MOUNTED, task.DISK_STATUS_NO_FSTAB_ENTRY:
+		return color.YellowString(status)
+	}
+	return color.RedString(status)
+}
+
+// usedBy is the services which data_dir is on the disk, keyed by host:device
+func FormatDiskStatus(statuses []task.DiskStatus, usedBy map[string][]string) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Device",
+		"Filesystem",
+		"UUID",
+		"Size",
+		"Mount Point",
+		"Mounted On",
+		"Fstab",
+		"Used By",
+		"Status",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, s := range statuses {
+		services := usedBy[s.Host+":"+s.Device]
+		lines = append(lines, []interface{}{
+			s.Host,
+			s.Device,
+			utils.Choose(len(s.Filesystem) > 0, s.Filesystem, "-"),
+			utils.Choose(len(s.UUID) > 0, s.UUID, "-"),
+			utils.Choose(s.Size > 0, humanize.IBytes(s.Size), "-"),
+			s.MountPoint,
+			utils.Choose(len(s.MountedOn) > 0, s.MountedOn, "-"),
+			utils.Choose(s.InFstab, "Y", "N"),
+			utils.Choose(len(services) > 0, strings.Join(services, ","), "-"),
+			tuicommon.DecorateMessage{Message: s.Status, Decorate: diskStatusDecorate},
+		})
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
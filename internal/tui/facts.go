@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+)
+
+func formatNICSpeed(speed int) string {
+	if speed == task.NIC_SPEED_UNKNOWN {
+		return "-"
+	} else if speed >= 1000 && speed%1000 == 0 {
+		return fmt.Sprintf("%dGb/s", speed/1000)
+	}
+	return fmt.Sprintf("%dMb/s", speed)
+}
+
+func formatCPU(cpu task.CPUFacts, verbose bool) string {
+	if cpu.Cores == 0 {
+		return "-"
+	}
+	model := cpu.Model
+	if !verbose && len(model) > FIELD_LIMIT_LENGTH {
+		model = model[:FIELD_LIMIT_LENGTH] + "..."
+	}
+	return fmt.Sprintf("%d x %s", cpu.Cores, model)
+}
+
+func formatNICs(nics []task.NIC) string {
+	items := []string{}
+	for _, nic := range nics {
+		if nic.State == "up" {
+			items = append(items, fmt.Sprintf("%s(%s)", nic.Name, formatNICSpeed(nic.Speed)))
+		}
+	}
+	return utils.Choose(len(items) > 0, strings.Join(items, ","), "-")
+}
+
+func countDisks(devices []task.BlockDevice) int {
+	n := 0
+	for _, device := range devices {
+		if device.Type == "disk" {
+			n++
+		}
+	}
+	return n
+}
+
+func fuseDecorate(message string) string {
+	if message == "Y" {
+		return color.GreenString(message)
+	}
+	return color.RedString(message)
+}
+
+func formatFactsDetail(facts task.HostFacts) string {
+	lines := [][]interface{}{}
+	first, second := tuicommon.FormatTitle([]string{
+		"Device",
+		"Type",
+		"Size",
+		"Rotational",
+		"FS Type",
+		"Mount Point",
+		"Model",
+	})
+	lines = append(lines, first, second)
+	for _, device := range facts.BlockDevices {
+		lines = append(lines, []interface{}{
+			device.Name,
+			device.Type,
+			humanize.IBytes(device.Size),
+			utils.Choose(device.Rotational, "Y", "N"),
+			utils.Choose(len(device.FsType) > 0, device.FsType, "-"),
+			utils.Choose(len(device.MountPoint) > 0, device.MountPoint, "-"),
+			utils.Choose(len(device.Model) > 0, device.Model, "-"),
+		})
+	}
+	output := tuicommon.FixedFormat(lines, 2)
+
+	lines = [][]interface{}{}
+	first, second = tuicommon.FormatTitle([]string{
+		"NIC",
+		"State",
+		"Speed",
+		"MTU",
+		"MAC",
+		"Addresses",
+	})
+	lines = append(lines, first, second)
+	for _, nic := range facts.NICs {
+		lines = append(lines, []interface{}{
+			nic.Name,
+			nic.State,
+			formatNICSpeed(nic.Speed),
+			strconv.Itoa(nic.MTU),
+			nic.MAC,
+			utils.Choose(len(nic.Addresses) > 0, strings.Join(nic.Addresses, ","), "-"),
+		})
+	}
+	output += "\n" + tuicommon.FixedFormat(lines, 2)
+
+	numa := []string{}
+	for _, node := range facts.NUMA {
+		numa = append(numa, fmt.Sprintf("node%d: cpus=%s memory=%s",
+			node.Id, node.CPUs, humanize.IBytes(node.Memory)))
+	}
+	if len(numa) > 0 {
+		output += "\n" + strings.Join(numa, "\n") + "\n"
+	}
+	for fact, err := range facts.Errors {
+		output += color.YellowString("collect %s failed: %s", fact, err) + "\n"
+	}
+	return output
+}
+
+func FormatHostFacts(facts []task.HostFacts, verbose bool) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Hostname",
+		"OS",
+		"Kernel",
+		"CPU",
+		"Memory",
+		"NUMA",
+		"Disks",
+		"NICs",
+		"Docker",
+		"Fuse",
+		"Collected At",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, f := range facts {
+		lines = append(lines, []interface{}{
+			f.Host,
+			utils.Choose(len(f.Hostname) > 0, f.Hostname, "-"),
+			utils.Choose(len(f.OS) > 0, f.OS, "-"),
+			utils.Choose(len(f.Kernel) > 0, f.Kernel, "-"),
+			formatCPU(f.CPU, verbose),
+			humanize.IBytes(f.Memory),
+			strconv.Itoa(len(f.NUMA)),
+			strconv.Itoa(countDisks(f.BlockDevices)),
+			formatNICs(f.NICs),
+			utils.Choose(len(f.Docker) > 0, f.Docker, "-"),
+			tuicommon.DecorateMessage{Message: utils.Choose(f.Fuse, "Y", "N"), Decorate: fuseDecorate},
+			f.CollectedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	output := tuicommon.FixedFormat(lines, 2)
+	if verbose {
+		for _, f := range facts {
+			output += fmt.Sprintf("\n%s\n", color.BlueString("[%s]", f.Host))
+			output += formatFactsDetail(f)
+		}
+	}
+	return output
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/dingodb/dingocli/internal/tui/common"
 	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/module"
+	"github.com/fatih/color"
 )
 
 const (
@@ -39,6 +41,7 @@ func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
 		"Port",
 		"Private Key File",
 		"Forward Agent",
+		"Auth",
 		"Become User",
 		"Labels",
 		"Envs",
@@ -59,6 +62,14 @@ func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
 		labels := utils.Choose(len(hc.GetLabels()) > 0, strings.Join(hc.GetLabels(), ","), "-")
 		envs := utils.Choose(len(hc.GetEnvs()) > 0, strings.Join(hc.GetEnvs(), ","), "-")
 		privateKeyFile := hc.GetPrivateKeyFile()
+		auth := hc.GetAuthMethod()
+		if hc.GetForwardAgent() {
+			auth = "agent"
+		} else if auth == configure.AUTH_METHOD_PASSWORD {
+			privateKeyFile = ""
+		} else if len(hc.GetCertificateFile()) > 0 {
+			auth += "+cert"
+		}
 		if len(privateKeyFile) == 0 {
 			privateKeyFile = "-"
 		} else if !verbose && len(hc.GetPrivateKeyFile()) > FIELD_LIMIT_LENGTH {
@@ -72,6 +83,7 @@ func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
 			port,
 			privateKeyFile,
 			forwardAgent,
+			auth,
 			becomeUser,
 			labels,
 			envs,
@@ -80,3 +92,46 @@ func FormatHosts(hcs []*configure.HostConfig, verbose bool) string {
 
 	return common.FixedFormat(lines, 2)
 }
+
+type HostKey struct {
+	Host        string
+	Address     string
+	KeyType     string
+	Fingerprint string
+	Status      string
+}
+
+func hostKeyStatusDecorate(status string) string {
+	switch status {
+	case module.HOST_KEY_KNOWN, module.HOST_KEY_ADDED:
+		return color.GreenString(status)
+	}
+	return color.RedString(status)
+}
+
+func FormatHostKeys(keys []HostKey) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Address",
+		"Key Type",
+		"Fingerprint",
+		"Status",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, key := range keys {
+		status := tuicommon.DecorateMessage{Message: key.Status, Decorate: hostKeyStatusDecorate}
+		lines = append(lines, []interface{}{
+			key.Host,
+			key.Address,
+			utils.Choose(len(key.KeyType) > 0, key.KeyType, "-"),
+			utils.Choose(len(key.Fingerprint) > 0, key.Fingerprint, "-"),
+			status,
+		})
+	}
+
+	return common.FixedFormat(lines, 2)
+}
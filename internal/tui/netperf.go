@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/internal/netperf"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/fatih/color"
+)
+
+// IsSlowLink returns true if the link failed or falls below thresholds
+func IsSlowLink(r netperf.Result, maxLatency, minThroughput float64) bool {
+	return len(r.Error) > 0 || r.LatencyAvg > maxLatency || r.Throughput < minThroughput
+}
+
+/*
+ * From \ To   host1              host2
+ * ---------   -----              -----
+ * host1       -                  0.12ms 9.41Gbps
+ * host2       0.11ms 9.39Gbps    -
+ */
+func FormatNetperfMatrix(hosts []string,
+	results map[string]map[string]netperf.Result,
+	maxLatency, minThroughput float64) string {
+	lines := [][]interface{}{}
+	title := append([]string{"From \\ To"}, hosts...)
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, from := range hosts {
+		line := []interface{}{from}
+		for _, to := range hosts {
+			r, ok := results[from][to]
+			if from == to {
+				line = append(line, "-")
+				continue
+			} else if !ok || len(r.Error) > 0 {
+				line = append(line, tuicommon.DecorateMessage{
+					Message:  "failed",
+					Decorate: func(s string) string { return color.RedString(s) },
+				})
+				continue
+			}
+
+			message := fmt.Sprintf("%.2fms %.2fGbps", r.LatencyAvg, r.Throughput/1000)
+			decorate := func(s string) string { return color.GreenString(s) }
+			if IsSlowLink(r, maxLatency, minThroughput) {
+				decorate = func(s string) string { return color.YellowString(s) }
+			}
+			line = append(line, tuicommon.DecorateMessage{Message: message, Decorate: decorate})
+		}
+		lines = append(lines, line)
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package service
+
+import (
+	"sort"
+
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dustin/go-humanize"
+)
+
+// sort core files by time descending, the latest one is on the top
+func SortCoreFiles(cores []task.CoreFile) {
+	sort.SliceStable(cores, func(i, j int) bool {
+		if !cores[i].Time.Equal(cores[j].Time) {
+			return cores[i].Time.After(cores[j].Time)
+		}
+		return cores[i].Id < cores[j].Id
+	})
+}
+
+func FormatCoreFiles(cores []task.CoreFile) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Host",
+		"Role",
+		"Service Id",
+		"Time",
+		"Size",
+		"Binary",
+		"Path",
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	for _, core := range cores {
+		lines = append(lines, []interface{}{
+			core.Id,
+			core.Host,
+			core.Role,
+			core.ServiceId,
+			core.Time.Format("2006-01-02 15:04:05"),
+			humanize.IBytes(uint64(core.Size)),
+			core.Binary,
+			core.Path,
+		})
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
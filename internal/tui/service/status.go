@@ -86,6 +86,9 @@ var (
 		configure.ROLE_NODE_EXPORTER: 1,
 		configure.ROLE_PROMETHEUS:    2,
 		configure.ROLE_GRAFANA:       3,
+		configure.ROLE_ALERTMANAGER:  4,
+		configure.ROLE_LOKI:          5,
+		configure.ROLE_PROMTAIL:      6,
 	}
 )
 
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package service
+
+import (
+	"sort"
+	"strings"
+
+	comm "github.com/dingodb/dingocli/internal/common"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+)
+
+func topStatusDecorate(status string) string {
+	if strings.HasPrefix(status, "Up") {
+		return color.GreenString(status)
+	}
+	return color.RedString(status)
+}
+
+func onlineDecorate(online string) string {
+	switch online {
+	case comm.ROW_VALUE_ONLINE:
+		return color.GreenString(online)
+	case comm.ROW_VALUE_OFFLINE:
+		return color.RedString(online)
+	}
+	return online
+}
+
+func restartsDecorate(restarts string) string {
+	if restarts != "0" && restarts != task.SERVICE_TOP_ABSENT {
+		return color.YellowString(restarts)
+	}
+	return restarts
+}
+
+func sortServiceTops(tops []task.ServiceTop) {
+	sort.Slice(tops, func(i, j int) bool {
+		s1, s2 := tops[i], tops[j]
+		c1, c2 := s1.Config, s2.Config
+		if s1.Role == s2.Role {
+			if c1.GetHostSequence() == c2.GetHostSequence() {
+				return c1.GetInstancesSequence() < c2.GetInstancesSequence()
+			}
+			return c1.GetHostSequence() < c2.GetHostSequence()
+		}
+		return ROLE_SCORE[s1.Role] < ROLE_SCORE[s2.Role]
+	})
+}
+
+func FormatServiceTop(tops []task.ServiceTop) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Id",
+		"Role",
+		"Host",
+		"Container Id",
+		"Status",
+		"Leader",
+		"Online",
+		"CPU",
+		"Mem Usage",
+		"Mem %",
+		"Restarts",
+	}
+	first, second := tui.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	sortServiceTops(tops)
+	for _, top := range tops {
+		lines = append(lines, []interface{}{
+			top.Id,
+			top.Role,
+			top.Host,
+			top.ContainerId,
+			tui.DecorateMessage{Message: top.Status, Decorate: topStatusDecorate},
+			utils.Choose(top.IsLeader, "*", ""),
+			tui.DecorateMessage{Message: top.Online, Decorate: onlineDecorate},
+			top.CPU,
+			top.MemUsage,
+			top.MemPercent,
+			tui.DecorateMessage{Message: top.Restarts, Decorate: restartsDecorate},
+		})
+	}
+
+	return tui.FixedFormat(lines, 2)
+}
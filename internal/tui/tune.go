@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package tui
+
+import (
+	"sort"
+
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+	tuicommon "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/fatih/color"
+)
+
+func tuneActionDecorate(action string) string {
+	switch action {
+	case task.TUNE_ACTION_OK:
+		return color.GreenString(action)
+	case task.TUNE_ACTION_CHANGED, task.TUNE_ACTION_REVERTED:
+		return color.BlueString(action)
+	case task.TUNE_ACTION_DRIFT, task.TUNE_ACTION_UNSUPPORTED, task.TUNE_ACTION_SKIPPED:
+		return color.YellowString(action)
+	}
+	return color.RedString(action)
+}
+
+func FormatTuneResults(results map[string][]task.TuneResult) string {
+	lines := [][]interface{}{}
+	title := []string{
+		"Host",
+		"Kind",
+		"Item",
+		"Before",
+		"Expected",
+		"Action",
+	}
+	first, second := tuicommon.FormatTitle(title)
+	lines = append(lines, first)
+	lines = append(lines, second)
+
+	hosts := []string{}
+	for host := range results {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		for _, r := range results[host] {
+			lines = append(lines, []interface{}{
+				r.Host,
+				r.Kind,
+				r.Key,
+				utils.Choose(len(r.Before) > 0, r.Before, "-"),
+				utils.Choose(len(r.Expected) > 0, r.Expected, "-"),
+				tuicommon.DecorateMessage{Message: r.Action, Decorate: tuneActionDecorate},
+			})
+		}
+	}
+
+	return tuicommon.FixedFormat(lines, 2)
+}
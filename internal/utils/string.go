@@ -209,3 +209,25 @@ func Ternary(cond bool, trueVal, falseVal string) string {
 	}
 	return falseVal
 }
+
+// ParseStats parses the metrics of .stats file or brpc /vars which one line
+// is "name : value", the value which is not a number is ignored
+func ParseStats(data string) map[string]float64 {
+	outstr := strings.ReplaceAll(data, "\r", "")
+	outstr = strings.ReplaceAll(outstr, " ", "")
+
+	metricDataMap := make(map[string]float64)
+	lines := strings.Split(string(outstr), "\n")
+
+	for _, line := range lines {
+		fields := strings.Split(line, ":")
+		if len(fields) == 2 {
+			v, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				continue
+			}
+			metricDataMap[fields[0]] = v
+		}
+	}
+	return metricDataMap
+}
@@ -17,7 +17,9 @@
 package module
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"strings"
 	"text/template"
 )
@@ -39,9 +41,11 @@ const (
 	TEMPLATE_INSPECT_CONTAINER                      = "{{.engine}} inspect {{.options}} {{.container}}"
 	TEMPLATE_CONTAINER_LOGS                         = "{{.engine}} logs {{.options}} {{.container}}"
 	TEMPLATE_UPDATE_CONTAINER                       = "{{.engine}} update {{.options}} {{.container}}"
+	TEMPLATE_CONTAINER_STATS                        = "{{.engine}} stats {{.options}} {{.containers}}"
 )
 
 type DockerCli struct {
+	ctx       context.Context
 	sshClient *SSHClient
 	options   []string
 	tmpl      *template.Template
@@ -50,6 +54,7 @@ type DockerCli struct {
 
 func NewDockerCli(sshClient *SSHClient) *DockerCli {
 	return &DockerCli{
+		ctx:       context.Background(),
 		sshClient: sshClient,
 		options:   []string{},
 		tmpl:      nil,
@@ -57,6 +62,14 @@ func NewDockerCli(sshClient *SSHClient) *DockerCli {
 	}
 }
 
+// WithContext binds the context which is used to cancel the executing command
+func (cli *DockerCli) WithContext(ctx context.Context) *DockerCli {
+	if ctx != nil {
+		cli.ctx = ctx
+	}
+	return cli
+}
+
 func (s *DockerCli) AddOption(format string, args ...interface{}) *DockerCli {
 	s.options = append(s.options, fmt.Sprintf(format, args...))
 	return s
@@ -65,7 +78,14 @@ func (s *DockerCli) AddOption(format string, args ...interface{}) *DockerCli {
 func (cli *DockerCli) Execute(options ExecOptions) (string, error) {
 	cli.data["options"] = strings.Join(cli.options, " ")
 	cli.data["engine"] = options.ExecWithEngine
-	return execCommand(cli.sshClient, cli.tmpl, cli.data, options)
+	return execCommand(cli.ctx, cli.sshClient, cli.tmpl, cli.data, options)
+}
+
+// Stream executes the command and writes its output to writer until it exits
+func (cli *DockerCli) Stream(options ExecOptions, writer io.Writer) error {
+	cli.data["options"] = strings.Join(cli.options, " ")
+	cli.data["engine"] = options.ExecWithEngine
+	return streamCommand(cli.ctx, cli.sshClient, cli.tmpl, cli.data, options, writer)
 }
 
 func (cli *DockerCli) DockerInfo() *DockerCli {
@@ -159,3 +179,9 @@ func (cli *DockerCli) ContainerLogs(containerId string) *DockerCli {
 	cli.data["container"] = containerId
 	return cli
 }
+
+func (cli *DockerCli) ContainerStats(containerId ...string) *DockerCli {
+	cli.tmpl = template.Must(template.New("ContainerStats").Parse(TEMPLATE_CONTAINER_STATS))
+	cli.data["containers"] = strings.Join(containerId, " ")
+	return cli
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 	"text/template"
@@ -31,6 +32,7 @@ import (
 
 type (
 	Module struct {
+		ctx       context.Context
 		sshClient *SSHClient
 	}
 
@@ -52,12 +54,12 @@ func (e *TimeoutError) Error() string {
 		e.timeout)
 }
 
-func NewModule(sshClient *SSHClient) *Module {
-	return &Module{sshClient: sshClient}
+func NewModule(ctx context.Context, sshClient *SSHClient) *Module {
+	return &Module{ctx: ctx, sshClient: sshClient}
 }
 
 func (m *Module) Shell() *Shell {
-	return NewShell(m.sshClient)
+	return NewShell(m.sshClient).WithContext(m.ctx)
 }
 
 func (m *Module) File() *FileManager {
@@ -65,7 +67,7 @@ func (m *Module) File() *FileManager {
 }
 
 func (m *Module) DockerCli() *DockerCli {
-	return NewDockerCli(m.sshClient)
+	return NewDockerCli(m.sshClient).WithContext(m.ctx)
 }
 
 // common utils
@@ -78,7 +80,8 @@ func remoteAddr(client *SSHClient) string {
 	return fmt.Sprintf("%s@%s:%d", config.User, config.Host, config.Port)
 }
 
-func execCommand(sshClient *SSHClient,
+// render command template and wrap it with 'sudo' and 'become_user'
+func renderCommand(sshClient *SSHClient,
 	tmpl *template.Template,
 	data map[string]interface{},
 	options ExecOptions) (string, error) {
@@ -109,9 +112,25 @@ func execCommand(sshClient *SSHClient,
 			command = strings.Join([]string{become, command}, " ")
 		}
 	}
+	return command, nil
+}
+
+func execCommand(parent context.Context,
+	sshClient *SSHClient,
+	tmpl *template.Template,
+	data map[string]interface{},
+	options ExecOptions) (string, error) {
+	command, err := renderCommand(sshClient, tmpl, data, options)
+	if err != nil {
+		return "", err
+	}
 
-	// (4) create context for timeout
-	ctx := context.Background()
+	// (4) create context for timeout, the parent context may be canceled
+	//     by user (e.g. Ctrl-C) or reach the deadline of playbook step
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx := parent
 	if options.ExecTimeoutSec > 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(options.ExecTimeoutSec)*time.Second)
@@ -120,7 +139,6 @@ func execCommand(sshClient *SSHClient,
 
 	// (5) execute command
 	var out []byte
-	var err error
 	if options.ExecInLocal {
 		cmd := exec.CommandContext(ctx, "bash", "-c", command)
 		cmd.Env = []string{"LANG=en_US.UTF-8"}
@@ -133,7 +151,9 @@ func execCommand(sshClient *SSHClient,
 		}
 	}
 
-	if ctx.Err() == context.DeadlineExceeded {
+	if parent.Err() != nil {
+		err = parent.Err()
+	} else if ctx.Err() == context.DeadlineExceeded {
 		err = &TimeoutError{options.ExecTimeoutSec}
 	}
 
@@ -144,3 +164,49 @@ func execCommand(sshClient *SSHClient,
 		log.Field("error", err))
 	return string(out), err
 }
+
+/*
+ * streamCommand likes execCommand, but writes the output (stdout and stderr)
+ * to writer as soon as it arrives, it's used for long-running command
+ * which never exits unless canceled, e.g. 'tail -f'.
+ */
+func streamCommand(ctx context.Context,
+	sshClient *SSHClient,
+	tmpl *template.Template,
+	data map[string]interface{},
+	options ExecOptions,
+	writer io.Writer) error {
+	command, err := renderCommand(sshClient, tmpl, data, options)
+	if err != nil {
+		return err
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if options.ExecInLocal {
+		cmd := exec.CommandContext(ctx, "bash", "-c", command)
+		cmd.Env = []string{"LANG=en_US.UTF-8"}
+		cmd.Stdout = writer
+		cmd.Stderr = writer
+		err = cmd.Run()
+	} else {
+		var cmd *goph.Cmd
+		cmd, err = sshClient.Client().CommandContext(ctx, command)
+		if err == nil {
+			cmd.Stdout = writer
+			cmd.Stderr = writer
+			err = cmd.Run()
+		}
+	}
+
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	log.SwitchLevel(err)("Stream command",
+		log.Field("remoteAddr", remoteAddr(sshClient)),
+		log.Field("command", command),
+		log.Field("error", err))
+	return err
+}
@@ -18,7 +18,9 @@ package module
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"strings"
 	"text/template"
 )
@@ -76,6 +78,7 @@ const (
 
 // TODO(P1): support command pipe
 type Shell struct {
+	ctx       context.Context
 	sshClient *SSHClient
 	options   []string
 	tmpl      *template.Template
@@ -84,6 +87,7 @@ type Shell struct {
 
 func NewShell(sshClient *SSHClient) *Shell {
 	return &Shell{
+		ctx:       context.Background(),
 		sshClient: sshClient,
 		options:   []string{},
 		tmpl:      nil,
@@ -91,6 +95,14 @@ func NewShell(sshClient *SSHClient) *Shell {
 	}
 }
 
+// WithContext binds the context which is used to cancel the executing command
+func (s *Shell) WithContext(ctx context.Context) *Shell {
+	if ctx != nil {
+		s.ctx = ctx
+	}
+	return s
+}
+
 func (s *Shell) AddOption(format string, args ...interface{}) *Shell {
 	s.options = append(s.options, fmt.Sprintf(format, args...))
 	return s
@@ -113,7 +125,13 @@ func (s *Shell) String() (string, error) {
 
 func (s *Shell) Execute(options ExecOptions) (string, error) {
 	s.data["options"] = strings.Join(s.options, " ")
-	return execCommand(s.sshClient, s.tmpl, s.data, options)
+	return execCommand(s.ctx, s.sshClient, s.tmpl, s.data, options)
+}
+
+// Stream executes the command and writes its output to writer until it exits
+func (s *Shell) Stream(options ExecOptions, writer io.Writer) error {
+	s.data["options"] = strings.Join(s.options, " ")
+	return streamCommand(s.ctx, s.sshClient, s.tmpl, s.data, options, writer)
 }
 
 // text
@@ -18,7 +18,10 @@ package module
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"os"
+	"sync/atomic"
 	"time"
 
 	log "github.com/dingodb/dingocli/pkg/log/glg"
@@ -36,110 +39,292 @@ type (
 		BecomeFlags       string
 		BecomeUser        string
 		PrivateKeyPath    string
+		Passphrase        SecretFunc // passphrase for encrypted private key
+		CertificatePath   string     // OpenSSH user certificate signed for the private key
+		Password          SecretFunc // password authentication is disabled if nil
 		ConnectRetries    int
 		ConnectTimeoutSec int
+		HostKeyPolicy     string      // strict, accept-new or ask, default is accept-new
+		ProxyJump         []SSHConfig // jump hosts in order, the first one is connected directly
 	}
 
+	// SecretFunc returns the secret on demand, e.g. prompt user or read from env
+	SecretFunc func() (string, error)
+
 	SSHClient struct {
 		client *goph.Client
+		jumps  []*ssh.Client
 		config SSHConfig
 	}
 )
 
-func askIsHostTrusted(host string, key ssh.PublicKey) bool {
-	//	format := "Unknown Host: %s \\nFingerprint: %s \\nWould you likt to add it?[y/N]: "
-	//	prompt := fmt.Sprintf(format, host, ssh.FingerprintSHA256(key))
-	//	return tui.ConfirmYes(prompt)
-	return true
+func (client *SSHClient) Client() *goph.Client {
+	return client.client
+}
+
+func (client *SSHClient) Config() SSHConfig {
+	return client.config
 }
 
-func VerifyHost(host string, remote net.Addr, key ssh.PublicKey) error {
-	hostFound, err := goph.CheckKnownHost(host, remote, key, "")
+// Close closes the connection to target host and then the jump hosts
+func (client *SSHClient) Close() error {
+	var err error
+	if client.client != nil {
+		err = client.client.Close()
+	}
+	for i := len(client.jumps) - 1; i >= 0; i-- {
+		client.jumps[i].Close()
+	}
+	return err
+}
 
-	/*
-	 * Host in known hosts but key mismatch!
-	 * Maybe because of MAN IN THE MIDDLE ATTACK!
-	 */
-	if hostFound && err != nil {
-		return err
-	} else if hostFound && err == nil { // handshake because public key already exists.
-		return nil
-	} else if askIsHostTrusted(host, key) == false { // Ask user to check if he trust the host public key.
-		// Make sure to return error on non trusted keys.
-		return errors.New("you typed no, aborted!")
+// Prepare resolves all secrets of host and jump hosts
+func (config SSHConfig) Prepare() error {
+	for _, jump := range config.ProxyJump {
+		if err := jump.Prepare(); err != nil {
+			return err
+		}
 	}
 
-	// Add the new host to known hosts file.
-	return goph.AddKnownHost(host, remote, key, "")
+	for _, secret := range []SecretFunc{config.Passphrase, config.Password} {
+		if secret == nil {
+			continue
+		} else if _, err := secret(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (client *SSHClient) Client() *goph.Client {
-	return client.client
+func newSigner(config SSHConfig) (ssh.Signer, error) {
+	data, err := os.ReadFile(config.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.ParsePrivateKey(data)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) && config.Passphrase != nil {
+		passphrase, err := config.Passphrase()
+		if err != nil {
+			return nil, err
+		}
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(data, []byte(passphrase))
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(config.CertificatePath) == 0 {
+		return signer, nil
+	}
+
+	data, err = os.ReadFile(config.CertificatePath)
+	if err != nil {
+		return nil, err
+	}
+	key, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	if err != nil {
+		return nil, err
+	}
+	cert, ok := key.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("%s: not a certificate", config.CertificatePath)
+	}
+	return ssh.NewCertSigner(cert, signer)
 }
 
-func (client *SSHClient) Config() SSHConfig {
-	return client.config
+// answer all keyboard-interactive questions with password, it's what sshd
+// asks for when PasswordAuthentication is disabled but PAM is enabled.
+func keyboardInteractive(password SecretFunc) ssh.KeyboardInteractiveChallenge {
+	return func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+		answers := make([]string, len(questions))
+		if len(questions) == 0 {
+			return answers, nil
+		}
+		secret, err := password()
+		if err != nil {
+			return nil, err
+		}
+		for i := range answers {
+			answers[i] = secret
+		}
+		return answers, nil
+	}
 }
 
-func NewSSHClient(config SSHConfig) (*SSHClient, error) {
-	user := config.User
-	host := config.Host
-	port := config.Port
-	forwardAgent := config.ForwardAgent
-	privateKeyPath := config.PrivateKeyPath
-	connTimeoutSec := config.ConnectTimeoutSec
-	maxRetries := config.ConnectRetries
-
-	var auth goph.Auth
-	var err error
-	if forwardAgent {
-		auth, err = goph.UseAgent()
-	} else {
-		auth, err = goph.Key(privateKeyPath, "")
+func newAuth(config SSHConfig) (goph.Auth, error) {
+	if config.ForwardAgent {
+		return goph.UseAgent()
 	}
 
+	auth := goph.Auth{}
+	if len(config.PrivateKeyPath) > 0 {
+		signer, err := newSigner(config)
+		if err != nil {
+			return nil, err
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if config.Password != nil {
+		auth = append(auth,
+			ssh.PasswordCallback(config.Password),
+			ssh.KeyboardInteractive(keyboardInteractive(config.Password)))
+	}
+	return auth, nil
+}
+
+func (config SSHConfig) timeout() time.Duration {
+	return time.Duration(config.ConnectTimeoutSec) * time.Second
+}
+
+func (config SSHConfig) hostKeyCallback() ssh.HostKeyCallback {
+	if len(config.HostKeyPolicy) == 0 {
+		return NewHostKeyCallback(HOST_KEY_POLICY_ACCEPT_NEW)
+	}
+	return NewHostKeyCallback(config.HostKeyPolicy)
+}
+
+func newClientConfig(config SSHConfig, callback ssh.HostKeyCallback) (*ssh.ClientConfig, error) {
+	auth, err := newAuth(config)
 	if err != nil {
 		log.Error("Create SSH auth",
-			log.Field("user", user),
-			log.Field("host", host),
-			log.Field("port", port),
-			log.Field("forwardAgent", forwardAgent),
-			log.Field("privateKeyPath", privateKeyPath),
+			log.Field("user", config.User),
+			log.Field("host", config.Host),
+			log.Field("port", config.Port),
+			log.Field("forwardAgent", config.ForwardAgent),
+			log.Field("privateKeyPath", config.PrivateKeyPath),
+			log.Field("certificatePath", config.CertificatePath),
+			log.Field("password", config.Password != nil),
 			log.Field("error", err))
 		return nil, err
 	}
 
+	return &ssh.ClientConfig{
+		User:            config.User,
+		Auth:            auth,
+		Timeout:         config.timeout(),
+		HostKeyCallback: callback,
+	}, nil
+}
+
+// handshake establishes the SSH connection over conn, the handshake is bounded
+// by the connect timeout, the conn is closed once timeout because the conn
+// forwarded by jump host (ssh channel) doesn't support deadline
+func handshake(conn net.Conn, addr string, cfg *ssh.ClientConfig) (*ssh.Client, error) {
+	var expired atomic.Bool
+	if cfg.Timeout > 0 {
+		timer := time.AfterFunc(cfg.Timeout, func() {
+			expired.Store(true)
+			conn.Close()
+		})
+		defer timer.Stop()
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, cfg)
+	if err != nil {
+		conn.Close()
+		if expired.Load() {
+			return nil, fmt.Errorf("ssh handshake with %s timeout after %s", addr, cfg.Timeout)
+		}
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// dial the address through the previous hop, or directly if there is no hop
+func dial(prev *ssh.Client, config SSHConfig, cfg *ssh.ClientConfig) (*ssh.Client, error) {
+	var conn net.Conn
+	var err error
+	addr := net.JoinHostPort(config.Host, fmt.Sprint(config.Port))
+	if prev == nil {
+		conn, err = net.DialTimeout("tcp", addr, config.timeout())
+	} else {
+		conn, err = prev.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handshake(conn, addr, cfg)
+}
+
+// connect to the target host through all jump hosts
+func connect(config SSHConfig) (*SSHClient, error) {
+	var prev *ssh.Client
+	jumps := []*ssh.Client{}
+	closeJumps := func() {
+		for i := len(jumps) - 1; i >= 0; i-- {
+			jumps[i].Close()
+		}
+	}
+
+	dialHop := func(prev *ssh.Client, config SSHConfig) (*ssh.Client, error) {
+		cfg, err := newClientConfig(config, config.hostKeyCallback())
+		if err != nil {
+			return nil, err
+		}
+		return dial(prev, config, cfg)
+	}
+
+	for _, jump := range config.ProxyJump {
+		c, err := dialHop(prev, jump)
+		if err != nil {
+			closeJumps()
+			return nil, fmt.Errorf("connect jump host %s@%s:%d: %w",
+				jump.User, jump.Host, jump.Port, err)
+		}
+		jumps = append(jumps, c)
+		prev = c
+	}
+
+	c, err := dialHop(prev, config)
+	if err != nil {
+		closeJumps()
+		return nil, err
+	}
+
+	return &SSHClient{
+		client: &goph.Client{
+			Client: c,
+			Config: &goph.Config{
+				User:     config.User,
+				Addr:     config.Host,
+				Port:     config.Port,
+				Timeout:  config.timeout(),
+				Callback: config.hostKeyCallback(),
+			},
+		},
+		jumps:  jumps,
+		config: config,
+	}, nil
+}
+
+func NewSSHClient(config SSHConfig) (*SSHClient, error) {
 	tries := 0
 connect:
 	tries++
-	client, err := goph.NewConn(&goph.Config{
-		User:     user,
-		Addr:     host,
-		Port:     port,
-		Auth:     auth,
-		Timeout:  time.Duration(connTimeoutSec) * time.Second,
-		Callback: VerifyHost,
-	})
+	client, err := connect(config)
 
 	log.SwitchLevel(err)("Connect remote SSH",
-		log.Field("user", user),
-		log.Field("host", host),
-		log.Field("port", port),
-		log.Field("forwardAgent", forwardAgent),
-		log.Field("privateKeyPath", privateKeyPath),
-		log.Field("timeoutSec", connTimeoutSec),
-		log.Field("maxRetries", maxRetries),
+		log.Field("user", config.User),
+		log.Field("host", config.Host),
+		log.Field("port", config.Port),
+		log.Field("forwardAgent", config.ForwardAgent),
+		log.Field("privateKeyPath", config.PrivateKeyPath),
+		log.Field("proxyJump", len(config.ProxyJump)),
+		log.Field("timeoutSec", config.ConnectTimeoutSec),
+		log.Field("maxRetries", config.ConnectRetries),
 		log.Field("tries", tries),
 		log.Field("error", err))
 
+	var hostKeyErr *HostKeyError
 	if err != nil {
-		if tries < maxRetries {
+		if tries < config.ConnectRetries && !errors.As(err, &hostKeyErr) {
 			goto connect
 		}
+		return nil, err
 	}
-
-	return &SSHClient{
-		client: client,
-		config: config,
-	}, err
+	return client, nil
 }
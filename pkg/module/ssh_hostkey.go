@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/melbahja/goph"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// how to handle the host key which not in known_hosts
+	HOST_KEY_POLICY_STRICT     = "strict"     // reject it
+	HOST_KEY_POLICY_ACCEPT_NEW = "accept-new" // add it to known_hosts
+	HOST_KEY_POLICY_ASK        = "ask"        // ask user whether to add it
+
+	// status of scanned host key
+	HOST_KEY_KNOWN    = "known"
+	HOST_KEY_ADDED    = "added"
+	HOST_KEY_MISMATCH = "mismatch"
+	HOST_KEY_REJECTED = "rejected"
+)
+
+var (
+	ErrHostKeyUnknown  = errors.New("host key is unknown")
+	ErrHostKeyMismatch = errors.New("host key mismatch")
+	ErrHostKeyRejected = errors.New("host key is rejected")
+
+	// serialize checking and recording known_hosts among concurrent tasks
+	hostKeyMutex sync.Mutex
+)
+
+type (
+	HostKeyError struct {
+		Host        string
+		Fingerprint string
+		Err         error
+	}
+
+	HostKeyScan struct {
+		Address string
+		Key     ssh.PublicKey
+		Status  string
+	}
+)
+
+func (e *HostKeyError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", e.Host, e.Err, e.Fingerprint)
+}
+
+func (e *HostKeyError) Unwrap() error {
+	return e.Err
+}
+
+func newHostKeyError(host string, key ssh.PublicKey, err error) error {
+	return &HostKeyError{
+		Host:        host,
+		Fingerprint: ssh.FingerprintSHA256(key),
+		Err:         err,
+	}
+}
+
+func askIsHostTrusted(host string, key ssh.PublicKey) bool {
+	fmt.Printf("The authenticity of host '%s' can't be established.\n", host)
+	fmt.Printf("%s key fingerprint is %s.\n", key.Type(), ssh.FingerprintSHA256(key))
+	fmt.Print("Are you sure you want to continue connecting [yes/no]: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(input) == "yes"
+}
+
+// return ErrHostKeyUnknown if host not found in known_hosts
+func checkHostKey(host string, remote net.Addr, key ssh.PublicKey) error {
+	path, err := goph.DefaultKnownHostsPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return newHostKeyError(host, key, ErrHostKeyUnknown)
+	}
+
+	callback, err := knownhosts.New(path)
+	if err != nil {
+		return err
+	}
+
+	err = callback(host, remote, key)
+	var keyErr *knownhosts.KeyError
+	if errors.As(err, &keyErr) {
+		if len(keyErr.Want) > 0 { // maybe man in the middle attack or host reinstalled
+			return newHostKeyError(host, key, ErrHostKeyMismatch)
+		}
+		return newHostKeyError(host, key, ErrHostKeyUnknown)
+	}
+	return err
+}
+
+func addHostKey(host string, remote net.Addr, key ssh.PublicKey) error {
+	path, err := goph.DefaultKnownHostsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return goph.AddKnownHost(host, remote, key, path)
+}
+
+func NewHostKeyCallback(policy string) ssh.HostKeyCallback {
+	return func(host string, remote net.Addr, key ssh.PublicKey) error {
+		hostKeyMutex.Lock()
+		defer hostKeyMutex.Unlock()
+
+		err := checkHostKey(host, remote, key)
+		if !errors.Is(err, ErrHostKeyUnknown) {
+			return err
+		}
+
+		// the tasks connect hosts concurrently while the progress bars are drawing,
+		// so the unknown host key is confirmed by 'dingo hosts trust' in advance
+		switch policy {
+		case HOST_KEY_POLICY_STRICT, HOST_KEY_POLICY_ASK:
+			return err
+		}
+		return addHostKey(host, remote, key)
+	}
+}
+
+// record the scanned host key, the unknown key will be added to known_hosts,
+// user is asked for the unknown key if the policy is "ask"
+func scanHostKeyCallback(scans *[]HostKeyScan, policy string) ssh.HostKeyCallback {
+	return func(host string, remote net.Addr, key ssh.PublicKey) error {
+		hostKeyMutex.Lock()
+		defer hostKeyMutex.Unlock()
+
+		scan := HostKeyScan{Address: host, Key: key, Status: HOST_KEY_KNOWN}
+		err := checkHostKey(host, remote, key)
+		if errors.Is(err, ErrHostKeyUnknown) {
+			if policy == HOST_KEY_POLICY_ASK && !askIsHostTrusted(host, key) {
+				scan.Status = HOST_KEY_REJECTED
+				err = newHostKeyError(host, key, ErrHostKeyRejected)
+			} else {
+				scan.Status = HOST_KEY_ADDED
+				err = addHostKey(host, remote, key)
+			}
+		} else if errors.Is(err, ErrHostKeyMismatch) {
+			scan.Status = HOST_KEY_MISMATCH
+		}
+
+		if err == nil || scan.Status == HOST_KEY_MISMATCH || scan.Status == HOST_KEY_REJECTED {
+			*scans = append(*scans, scan)
+		}
+		return err
+	}
+}
+
+/*
+ * ScanHostKeys records the host keys of jump hosts and target host in known_hosts,
+ * it has to login the jump hosts to reach the next one, but only does the
+ * key exchange with target host.
+ */
+func ScanHostKeys(config SSHConfig) ([]HostKeyScan, error) {
+	scans := []HostKeyScan{}
+
+	var prev *ssh.Client
+	jumps := []*ssh.Client{}
+	defer func() {
+		for i := len(jumps) - 1; i >= 0; i-- {
+			jumps[i].Close()
+		}
+	}()
+	for _, jump := range config.ProxyJump {
+		cfg, err := newClientConfig(jump, scanHostKeyCallback(&scans, jump.HostKeyPolicy))
+		if err != nil {
+			return scans, err
+		} else if jump.HostKeyPolicy == HOST_KEY_POLICY_ASK {
+			cfg.Timeout = 0 // the handshake waits for user
+		}
+		c, err := dial(prev, jump, cfg)
+		if err != nil {
+			return scans, err
+		}
+		jumps = append(jumps, c)
+		prev = c
+	}
+
+	n := len(scans)
+	cfg := &ssh.ClientConfig{
+		User:            config.User,
+		Timeout:         config.timeout(),
+		HostKeyCallback: scanHostKeyCallback(&scans, config.HostKeyPolicy),
+	}
+	if config.HostKeyPolicy == HOST_KEY_POLICY_ASK {
+		cfg.Timeout = 0
+	}
+	c, err := dial(prev, config, cfg)
+	if err == nil {
+		c.Close()
+	} else if len(scans) > n && (scans[n].Status == HOST_KEY_KNOWN || scans[n].Status == HOST_KEY_ADDED) {
+		err = nil // no auth method provided, failure is expected
+	}
+	return scans, err
+}
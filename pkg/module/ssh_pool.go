@@ -0,0 +1,358 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+)
+
+const (
+	DEFAULT_SSH_POOL_MAX_SESSIONS = 8 // sshd allows 10 sessions per connection by default
+	DEFAULT_SSH_POOL_IDLE_TIMEOUT = 5 * time.Minute
+)
+
+type (
+	SSHPoolOption struct {
+		Enable      bool          // dial a new connection for every task if disabled
+		MaxSessions int           // max number of borrowers sharing one connection
+		IdleTimeout time.Duration // close connection which is unused for this long, 0 means never
+	}
+
+	SSHPoolStats struct {
+		Dials     int           // connections established
+		Reuses    int           // connections borrowed from pool
+		Evictions int           // connections closed due to broken or idle
+		DialTime  time.Duration // total time spent on establishing connections
+	}
+
+	pooledClient struct {
+		client   *SSHClient
+		refs     int
+		lastUsed time.Time
+		broken   bool // evicted, closed once all borrowers put it back
+	}
+
+	// all connections to the same user@host:port (through the same jump hosts)
+	pooledHost struct {
+		dialMutex sync.Mutex // avoid concurrent tasks dialing the same host at the same time
+		clients   []*pooledClient
+	}
+
+	SSHPool struct {
+		mutex   sync.Mutex
+		option  SSHPoolOption
+		hosts   map[string]*pooledHost
+		stats   SSHPoolStats
+		closed  bool
+		once    sync.Once
+		stop    chan struct{}
+		dial    func(SSHConfig) (*SSHClient, error)
+		alive   func(*SSHClient) bool
+		release func(*SSHClient)
+	}
+)
+
+var globalSSHPool = NewSSHPool(SSHPoolOption{
+	Enable:      true,
+	MaxSessions: DEFAULT_SSH_POOL_MAX_SESSIONS,
+	IdleTimeout: DEFAULT_SSH_POOL_IDLE_TIMEOUT,
+})
+
+func GlobalSSHPool() *SSHPool {
+	return globalSSHPool
+}
+
+// ReplaceGlobalSSHPool replaces the process-wide pool, the old one will be closed.
+func ReplaceGlobalSSHPool(pool *SSHPool) {
+	old := globalSSHPool
+	globalSSHPool = pool
+	old.Close()
+}
+
+func NewSSHPool(option SSHPoolOption) *SSHPool {
+	if option.MaxSessions <= 0 {
+		option.MaxSessions = DEFAULT_SSH_POOL_MAX_SESSIONS
+	}
+	return &SSHPool{
+		option:  option,
+		hosts:   map[string]*pooledHost{},
+		stop:    make(chan struct{}),
+		dial:    NewSSHClient,
+		alive:   isAlive,
+		release: closeClient,
+	}
+}
+
+// the same address behind different jump hosts may be different hosts
+func poolKey(config SSHConfig) string {
+	key := fmt.Sprintf("%s@%s:%d", config.User, config.Host, config.Port)
+	for _, jump := range config.ProxyJump {
+		key = fmt.Sprintf("%s@%s:%d,%s", jump.User, jump.Host, jump.Port, key)
+	}
+	return key
+}
+
+// send keepalive request to make sure the connection is still usable
+func isAlive(client *SSHClient) bool {
+	_, _, err := client.Client().SendRequest("keepalive@openssh.com", true, nil)
+	return err == nil
+}
+
+func closeClient(client *SSHClient) {
+	client.Close()
+}
+
+func (p *SSHPool) Stats() SSHPoolStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.stats
+}
+
+// borrow an healthy connection which is under session limit, return nil if not found
+func (p *SSHPool) borrow(host *pooledHost) *SSHClient {
+	for {
+		p.mutex.Lock()
+		var candidate *pooledClient
+		for _, pc := range host.clients {
+			if !pc.broken && pc.refs < p.option.MaxSessions {
+				candidate = pc
+				break
+			}
+		}
+		if candidate == nil {
+			p.mutex.Unlock()
+			return nil
+		}
+		candidate.refs++
+		shared := candidate.refs > 1
+		p.mutex.Unlock()
+
+		// skip health check if the connection is being used by others
+		if shared || p.alive(candidate.client) {
+			p.mutex.Lock()
+			p.stats.Reuses++
+			p.mutex.Unlock()
+			return candidate.client
+		}
+
+		// the connection is broken, e.g. closed by remote sshd
+		p.Evict(candidate.client)
+	}
+}
+
+func (p *SSHPool) Get(config SSHConfig) (*SSHClient, error) {
+	p.mutex.Lock()
+	if !p.option.Enable || p.closed {
+		p.mutex.Unlock()
+		return p.connect(config)
+	}
+	key := poolKey(config)
+	host, ok := p.hosts[key]
+	if !ok {
+		host = &pooledHost{}
+		p.hosts[key] = host
+	}
+	p.mutex.Unlock()
+
+	if client := p.borrow(host); client != nil {
+		return client, nil
+	}
+
+	host.dialMutex.Lock()
+	defer host.dialMutex.Unlock()
+	if client := p.borrow(host); client != nil { // dialed by others
+		return client, nil
+	}
+
+	client, err := p.connect(config)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	host.clients = append(host.clients, &pooledClient{client: client, refs: 1})
+	p.mutex.Unlock()
+	p.once.Do(p.startJanitor)
+	return client, nil
+}
+
+func (p *SSHPool) connect(config SSHConfig) (*SSHClient, error) {
+	start := time.Now()
+	client, err := p.dial(config)
+	p.mutex.Lock()
+	p.stats.Dials++
+	p.stats.DialTime += time.Since(start)
+	p.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// find the pooled client, the caller should hold the mutex
+func (p *SSHPool) lookup(client *SSHClient) (*pooledHost, int) {
+	host, ok := p.hosts[poolKey(client.Config())]
+	if !ok {
+		return nil, -1
+	}
+	for i, pc := range host.clients {
+		if pc.client == client {
+			return host, i
+		}
+	}
+	return nil, -1
+}
+
+// Put returns the connection to pool, it will be closed if the pool is disabled,
+// or the pool is closed and nobody else is using it.
+func (p *SSHPool) Put(client *SSHClient) {
+	if client == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	host, i := p.lookup(client)
+	if host == nil {
+		p.mutex.Unlock()
+		p.release(client)
+		return
+	}
+	pc := host.clients[i]
+	pc.refs--
+	pc.lastUsed = time.Now()
+	if (p.closed || pc.broken) && pc.refs == 0 {
+		host.clients = append(host.clients[:i], host.clients[i+1:]...)
+		p.mutex.Unlock()
+		p.release(client)
+		return
+	}
+	p.mutex.Unlock()
+}
+
+// Evict puts back the broken connection instead of Put, it won't be borrowed
+// anymore and will be closed once the other borrowers put it back.
+func (p *SSHPool) Evict(client *SSHClient) {
+	if client == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	host, i := p.lookup(client)
+	if host == nil {
+		p.mutex.Unlock()
+		p.release(client)
+		return
+	}
+	pc := host.clients[i]
+	pc.refs--
+	if !pc.broken {
+		pc.broken = true
+		p.stats.Evictions++
+	}
+	if pc.refs > 0 {
+		p.mutex.Unlock()
+		return
+	}
+	host.clients = append(host.clients[:i], host.clients[i+1:]...)
+	p.mutex.Unlock()
+	p.release(client)
+}
+
+func (p *SSHPool) startJanitor() {
+	if p.option.IdleTimeout <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(p.option.IdleTimeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.evictIdle(time.Now())
+			}
+		}
+	}()
+}
+
+func (p *SSHPool) evictIdle(now time.Time) {
+	idles := []*SSHClient{}
+	p.mutex.Lock()
+	for _, host := range p.hosts {
+		clients := host.clients[:0]
+		for _, pc := range host.clients {
+			if pc.refs == 0 && now.Sub(pc.lastUsed) >= p.option.IdleTimeout {
+				idles = append(idles, pc.client)
+				p.stats.Evictions++
+			} else {
+				clients = append(clients, pc)
+			}
+		}
+		host.clients = clients
+	}
+	p.mutex.Unlock()
+
+	for _, client := range idles {
+		p.release(client)
+	}
+}
+
+// Close closes all pooled connections, connections which still
+// borrowed will be closed when they are put back.
+func (p *SSHPool) Close() {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.stop)
+
+	clients := []*SSHClient{}
+	for _, host := range p.hosts {
+		borrowed := host.clients[:0]
+		for _, pc := range host.clients {
+			if pc.refs == 0 {
+				clients = append(clients, pc.client)
+			} else {
+				borrowed = append(borrowed, pc)
+			}
+		}
+		host.clients = borrowed
+	}
+	stats := p.stats
+	p.mutex.Unlock()
+
+	for _, client := range clients {
+		p.release(client)
+	}
+
+	if stats.Dials > 0 {
+		log.Info("Close SSH connection pool",
+			log.Field("enable", p.option.Enable),
+			log.Field("dials", stats.Dials),
+			log.Field("reuses", stats.Reuses),
+			log.Field("evictions", stats.Evictions),
+			log.Field("dialTime", stats.DialTime.String()))
+	}
+}
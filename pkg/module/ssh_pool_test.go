@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kpango/glg"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func newFakeSSHPool(option SSHPoolOption) (*SSHPool, map[*SSHClient]bool) {
+	var mutex sync.Mutex
+	closed := map[*SSHClient]bool{}
+	pool := NewSSHPool(option)
+	pool.dial = func(config SSHConfig) (*SSHClient, error) {
+		return &SSHClient{config: config}, nil
+	}
+	pool.alive = func(client *SSHClient) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return !closed[client]
+	}
+	pool.release = func(client *SSHClient) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		closed[client] = true
+	}
+	return pool, closed
+}
+
+/*
+ * simulate a deploy which runs 10 steps on 30 hosts, run:
+ *   go test ./pkg/module -run ^TestSSHPoolDials$ -v
+ */
+func TestSSHPoolDials(t *testing.T) {
+	assert := assert.New(t)
+	for _, enable := range []bool{false, true} {
+		pool, _ := newFakeSSHPool(SSHPoolOption{Enable: enable})
+		for step := 0; step < 10; step++ {
+			var wg sync.WaitGroup
+			for i := 0; i < 30; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					config := SSHConfig{User: "dingo", Host: fmt.Sprintf("10.0.0.%d", i), Port: 22}
+					client, err := pool.Get(config)
+					assert.Nil(err)
+					pool.Put(client)
+				}(i)
+			}
+			wg.Wait()
+		}
+
+		stats := pool.Stats()
+		t.Logf("enable=%v dials=%d reuses=%d", enable, stats.Dials, stats.Reuses)
+		if enable {
+			assert.Equal(30, stats.Dials)
+			assert.Equal(270, stats.Reuses)
+		} else {
+			assert.Equal(300, stats.Dials)
+		}
+		pool.Close()
+	}
+}
+
+func TestSSHPoolMaxSessions(t *testing.T) {
+	assert := assert.New(t)
+	pool, _ := newFakeSSHPool(SSHPoolOption{Enable: true, MaxSessions: 2})
+	config := SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22}
+
+	c1, _ := pool.Get(config)
+	c2, _ := pool.Get(config)
+	c3, _ := pool.Get(config)
+	assert.Same(c1, c2)
+	assert.NotSame(c1, c3)
+
+	pool.Put(c2)
+	c4, _ := pool.Get(config)
+	assert.Same(c1, c4)
+	assert.Equal(2, pool.Stats().Dials)
+}
+
+func TestSSHPoolEvict(t *testing.T) {
+	assert := assert.New(t)
+	pool, closed := newFakeSSHPool(SSHPoolOption{Enable: true, IdleTimeout: time.Minute})
+	config := SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22}
+
+	// broken connection
+	c1, _ := pool.Get(config)
+	pool.Put(c1)
+	closed[c1] = true
+	c2, _ := pool.Get(config)
+	assert.NotSame(c1, c2)
+	assert.Equal(1, pool.Stats().Evictions)
+
+	// idle connection
+	pool.Put(c2)
+	pool.evictIdle(time.Now())
+	assert.False(closed[c2])
+	pool.evictIdle(time.Now().Add(time.Minute))
+	assert.True(closed[c2])
+	assert.Equal(2, pool.Stats().Evictions)
+
+	// close pool with borrowed connection
+	c3, _ := pool.Get(config)
+	pool.Close()
+	assert.False(closed[c3])
+	pool.Put(c3)
+	assert.True(closed[c3])
+}
+
+func TestSSHPoolEvictShared(t *testing.T) {
+	assert := assert.New(t)
+	pool, closed := newFakeSSHPool(SSHPoolOption{Enable: true})
+	config := SSHConfig{User: "dingo", Host: "10.0.0.1", Port: 22}
+
+	// the connection is still used by c2 after c1 evicted
+	c1, _ := pool.Get(config)
+	c2, _ := pool.Get(config)
+	assert.Same(c1, c2)
+	pool.Evict(c1)
+	assert.False(closed[c1])
+	assert.Equal(1, pool.Stats().Evictions)
+
+	// the broken connection won't be borrowed anymore
+	c3, _ := pool.Get(config)
+	assert.NotSame(c1, c3)
+
+	// closed once the last borrower put it back
+	pool.Put(c2)
+	assert.True(closed[c1])
+	assert.False(closed[c3])
+	assert.Equal(1, pool.Stats().Evictions)
+	pool.Put(c3)
+}
+
+// newTestSSHServer starts a SSH server which succeeds every command, returns its port
+func newTestSSHServer(b *testing.B) uint {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		b.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		b.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(ssh.ConnMetadata, []byte) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSH(conn, config)
+		}
+	}()
+	return uint(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func serveTestSSH(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs) // keepalive
+	for newChannel := range chans {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			for req := range requests {
+				req.Reply(req.Type == "exec", nil)
+				if req.Type == "exec" {
+					channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+					channel.Close()
+				}
+			}
+		}()
+	}
+}
+
+/*
+ * compare the pooled connection with dialing for every command, run:
+ *   go test ./pkg/module -run ^$ -bench ^BenchmarkSSHPool$
+ */
+func BenchmarkSSHPool(b *testing.B) {
+	b.Setenv("HOME", b.TempDir()) // the host key of test server is added into known_hosts
+	glg.Get().SetMode(glg.NONE)   // mute the log of every dial
+	config := SSHConfig{
+		User:              "dingo",
+		Host:              "127.0.0.1",
+		Port:              newTestSSHServer(b),
+		Password:          func() (string, error) { return "dingo", nil },
+		ConnectTimeoutSec: 3,
+	}
+
+	for _, enable := range []bool{true, false} {
+		b.Run(fmt.Sprintf("enable=%v", enable), func(b *testing.B) {
+			pool := NewSSHPool(SSHPoolOption{Enable: enable})
+			defer pool.Close()
+			for i := 0; i < b.N; i++ {
+				client, err := pool.Get(config)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := client.Client().Run("true"); err != nil {
+					b.Fatal(err)
+				}
+				pool.Put(client)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package module
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// the peer of jump host never answers the handshake
+func TestHandshakeTimeout(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	go func() {
+		buffer := make([]byte, 1024)
+		for {
+			if _, err := server.Read(buffer); err != nil {
+				return
+			}
+		}
+	}()
+
+	cfg := &ssh.ClientConfig{
+		User:            "root",
+		Timeout:         100 * time.Millisecond,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+	start := time.Now()
+	_, err := handshake(client, "10.0.0.1:22", cfg)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("expect handshake timeout, got %v", err)
+	} else if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("handshake returned after %s", elapsed)
+	}
+}
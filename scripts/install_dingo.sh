@@ -71,6 +71,10 @@ auto_upgrade = false
 [ssh_connections]
 retries = 3
 timeout = 10
+pool = true
+pool_max_sessions = 8
+pool_idle_timeout = 300
+ssh_host_key_policy = accept-new
 
 [database]
 url = "${g_db_path}"